@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	_ "github.com/lib/pq"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/database"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/handlers"
+	"github.com/oooooorg/PR-Service/internal/logger"
+)
+
+func main() {
+	configPath := flag.String("config", "config.yml", "path to the service config")
+	teamName := flag.String("team", "", "team to import ownership rules for")
+	filePath := flag.String("file", "CODEOWNERS", "path to the CODEOWNERS file")
+	flag.Parse()
+
+	env := os.Getenv("ENV")
+	if env == "" {
+		env = "development"
+	}
+
+	log := logger.New(env)
+	slog.SetDefault(log)
+
+	if *teamName == "" {
+		log.Error("Team name is required", slog.String("flag", "-team"))
+		os.Exit(2)
+	}
+
+	content, err := os.ReadFile(*filePath)
+	if err != nil {
+		log.Error("Failed to read CODEOWNERS file",
+			slog.String("error", err.Error()),
+			slog.String("path", *filePath),
+		)
+		os.Exit(1)
+	}
+
+	cfg, err := config.NewConfig(*configPath)
+	if err != nil {
+		log.Error("Failed to load config",
+			slog.String("error", err.Error()),
+			slog.String("path", *configPath),
+		)
+		os.Exit(1)
+	}
+
+	db, err := database.NewConnection(cfg)
+	if err != nil {
+		log.Error("Failed to connect to database",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	server := handlers.NewServer(log, db, cfg)
+
+	rules, unknownUsers, err := server.TeamService.ImportCodeowners(context.Background(), &api.PostTeamImportCodeownersJSONRequestBody{
+		TeamName: *teamName,
+		Content:  string(content),
+	})
+	_ = db.Close()
+	if err != nil {
+		log.Error("Failed to import CODEOWNERS",
+			slog.String("error", err.Error()),
+			slog.String("team", *teamName),
+		)
+		os.Exit(1)
+	}
+
+	for _, rule := range rules {
+		fmt.Printf("%s\t%s\n", rule.Pattern, strings.Join(rule.Owners, " "))
+	}
+
+	log.Info("CODEOWNERS imported",
+		slog.String("team", *teamName),
+		slog.Int("rules", len(rules)),
+	)
+
+	if len(unknownUsers) > 0 {
+		log.Warn("Unknown users skipped",
+			slog.String("users", strings.Join(unknownUsers, ", ")),
+		)
+		os.Exit(3)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/database"
 	api "github.com/oooooorg/PR-Service/internal/gen"
 	"github.com/oooooorg/PR-Service/internal/handlers"
 	"github.com/oooooorg/PR-Service/internal/middlewares"
@@ -43,6 +45,7 @@ func (app *App) Run() error {
 		middleware.Recover(),
 		middleware.CORS(),
 		middlewares.LoggerMiddleware(app.logger),
+		middlewares.ActorMiddleware(),
 		middlewares.PrometheusMiddleware(),
 	)
 
@@ -59,21 +62,67 @@ func (app *App) Run() error {
 		)
 	}
 
-	stopMetrics := make(chan struct{})
+	elector := database.NewLeaderElector(app.logger, app.db, app.cfg.LeaderElection)
+	stopElection := make(chan struct{})
+	electionDone := make(chan struct{})
 	go func() {
-		ticker := time.NewTicker(15 * time.Second)
-		defer ticker.Stop()
+		defer close(electionDone)
+		elector.Run(stopElection)
+	}()
+
+	stopJobs := make(chan struct{})
+	var jobs sync.WaitGroup
 
+	app.schedule(&jobs, stopJobs, "metrics updater", 15*time.Second, nil, func() {
+		middlewares.UpdateDBMetrics(app.db)
+	})
+
+	app.schedule(&jobs, stopJobs, "review reminders", app.cfg.Reminders.CheckInterval, elector, func() {
+		reminded, escalated, err := server.ReviewReminderService.ProcessSilentReviews(context.Background())
+		if err != nil {
+			app.logger.Error("Review reminder error", slog.String("error", err.Error()))
+			return
+		}
+		if reminded > 0 || escalated > 0 {
+			app.logger.Info("Processed silent reviews",
+				slog.Int("reminded", reminded),
+				slog.Int("escalated", escalated),
+			)
+		}
+	})
+
+	app.schedule(&jobs, stopJobs, "absence handover", app.cfg.Absences.CheckInterval, elector, func() {
+		reassignments, err := server.UserService.HandOverStartedAbsences(context.Background())
+		if err != nil {
+			app.logger.Error("Absence handover error", slog.String("error", err.Error()))
+			return
+		}
+		kept := 0
+		for _, reassignment := range reassignments {
+			if reassignment.Outcome == api.NoCandidate {
+				kept++
+			}
+		}
+		if len(reassignments) > 0 {
+			app.logger.Info("Handed over reviews of absent users",
+				slog.Int("reassignments", len(reassignments)-kept),
+				slog.Int("kept_without_candidate", kept),
+			)
+		}
+	})
+
+	app.schedule(&jobs, stopJobs, "outbox dispatcher", app.cfg.Outbox.PollInterval, elector, func() {
 		for {
-			select {
-			case <-ticker.C:
-				middlewares.UpdateDBMetrics(app.db)
-			case <-stopMetrics:
-				app.logger.Info("Stopping metrics updater")
+			delivered, err := server.OutboxDispatcher.DispatchPending(context.Background())
+			if err != nil {
+				app.logger.Error("Outbox dispatch error", slog.String("error", err.Error()))
+				return
+			}
+			if delivered < server.OutboxDispatcher.BatchSize() {
 				return
 			}
 		}
-	}()
+	})
 
 	go func() {
 		app.logger.Info("Starting HTTP server", slog.String("port", "8080"))
@@ -89,11 +138,19 @@ func (app *App) Run() error {
 	sig := <-quit
 	app.logger.Info("Received shutdown signal", slog.String("signal", sig.String()))
 
-	close(stopMetrics)
+	close(stopJobs)
+	jobs.Wait()
+
+	close(stopElection)
+	<-electionDone
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	if err := elector.Resign(ctx); err != nil {
+		app.logger.Error("Leadership resign error", slog.String("error", err.Error()))
+	}
+
 	if err := echoApp.Shutdown(ctx); err != nil {
 		app.logger.Error("Server shutdown error", slog.String("error", err.Error()))
 		return err
@@ -107,3 +164,26 @@ func (app *App) Run() error {
 	app.logger.Info("Server stopped gracefully")
 	return nil
 }
+
+func (app *App) schedule(jobs *sync.WaitGroup, stop <-chan struct{}, name string, interval time.Duration, elector *database.LeaderElector, run func()) {
+	jobs.Add(1)
+	go func() {
+		defer jobs.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if elector != nil && !elector.IsLeader() {
+					continue
+				}
+				run()
+			case <-stop:
+				app.logger.Info("Stopping background job", slog.String("job", name))
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type AbsencesConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+}
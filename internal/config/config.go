@@ -3,13 +3,23 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Database DatabaseConfig `yaml:"database"`
+	Server         ServerConfig         `yaml:"server"`
+	Database       DatabaseConfig       `yaml:"database"`
+	Reviewers      ReviewersConfig      `yaml:"reviewers"`
+	Absences       AbsencesConfig       `yaml:"absences"`
+	GitHub         GitHubConfig         `yaml:"github"`
+	GitLab         GitLabConfig         `yaml:"gitlab"`
+	Webhooks       WebhooksConfig       `yaml:"webhooks"`
+	Outbox         OutboxConfig         `yaml:"outbox"`
+	Slack          SlackConfig          `yaml:"slack"`
+	Reminders      RemindersConfig      `yaml:"reminders"`
+	LeaderElection LeaderElectionConfig `yaml:"leader_election"`
 }
 
 func NewConfig(configPath string) (*Config, error) {
@@ -47,6 +57,45 @@ func (c *Config) setDefaults() {
 	if c.Database.SSLMode == "" {
 		c.Database.SSLMode = "disable"
 	}
+	if c.Reviewers.Strategy == "" {
+		c.Reviewers.Strategy = ReviewerStrategyRandom
+	}
+	if c.Absences.CheckInterval <= 0 {
+		c.Absences.CheckInterval = time.Minute
+	}
+	if c.Reminders.CheckInterval <= 0 {
+		c.Reminders.CheckInterval = 15 * time.Minute
+	}
+	if c.Reminders.Action == "" {
+		c.Reminders.Action = ReminderActionReassign
+	}
+	if c.LeaderElection.LockID == 0 {
+		c.LeaderElection.LockID = DefaultLeaderLockID
+	}
+	if c.LeaderElection.CheckInterval <= 0 {
+		c.LeaderElection.CheckInterval = 5 * time.Second
+	}
+	if c.Outbox.PollInterval <= 0 {
+		c.Outbox.PollInterval = time.Second
+	}
+	if c.Outbox.BatchSize <= 0 {
+		c.Outbox.BatchSize = 100
+	}
+	if c.Outbox.Lease <= 0 {
+		c.Outbox.Lease = 5 * time.Minute
+	}
+	if c.Webhooks.Timeout <= 0 {
+		c.Webhooks.Timeout = 5 * time.Second
+	}
+	if c.Webhooks.MaxAttempts <= 0 {
+		c.Webhooks.MaxAttempts = 5
+	}
+	if c.Webhooks.InitialBackoff <= 0 {
+		c.Webhooks.InitialBackoff = time.Second
+	}
+	if c.Webhooks.MaxBackoff < c.Webhooks.InitialBackoff {
+		c.Webhooks.MaxBackoff = max(time.Minute, c.Webhooks.InitialBackoff)
+	}
 }
 
 func (c *Config) validate() error {
@@ -59,6 +108,29 @@ func (c *Config) validate() error {
 	if c.Database.DBName == "" {
 		return fmt.Errorf("database name is required")
 	}
+	if !isValidReviewerStrategy(c.Reviewers.Strategy) {
+		return fmt.Errorf("unknown reviewer strategy: %s", c.Reviewers.Strategy)
+	}
+	for team, strategy := range c.Reviewers.Teams {
+		if !isValidReviewerStrategy(strategy) {
+			return fmt.Errorf("unknown reviewer strategy for team %s: %s", team, strategy)
+		}
+	}
+	if !isValidReminderAction(c.Reminders.Action) {
+		return fmt.Errorf("unknown reminder action: %s", c.Reminders.Action)
+	}
+	for team := range c.Reminders.Teams {
+		policy := c.Reminders.PolicyForTeam(team)
+		if !isValidReminderAction(policy.Action) {
+			return fmt.Errorf("unknown reminder action for team %s: %s", team, policy.Action)
+		}
+		if policy.Action == ReminderActionEscalate && policy.Lead == "" {
+			return fmt.Errorf("reminder lead is required for team %s", team)
+		}
+	}
+	if c.Reminders.Action == ReminderActionEscalate && c.Reminders.Lead == "" {
+		return fmt.Errorf("reminder lead is required for escalate action")
+	}
 	return nil
 }
 
@@ -0,0 +1,11 @@
+package config
+
+type GitHubConfig struct {
+	WebhookSecret string            `yaml:"webhook_secret"`
+	Users         map[string]string `yaml:"users"`
+}
+
+func (g *GitHubConfig) UserIDForLogin(login string) (string, bool) {
+	userID, ok := g.Users[login]
+	return userID, ok
+}
@@ -0,0 +1,11 @@
+package config
+
+type GitLabConfig struct {
+	WebhookToken string            `yaml:"webhook_token"`
+	Users        map[string]string `yaml:"users"`
+}
+
+func (g *GitLabConfig) UserIDForUsername(username string) (string, bool) {
+	userID, ok := g.Users[username]
+	return userID, ok
+}
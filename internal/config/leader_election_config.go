@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+const DefaultLeaderLockID int64 = 7_246_001
+
+type LeaderElectionConfig struct {
+	LockID        int64         `yaml:"lock_id"`
+	CheckInterval time.Duration `yaml:"check_interval"`
+}
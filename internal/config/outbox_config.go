@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type OutboxConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval"`
+	BatchSize    int           `yaml:"batch_size"`
+	Lease        time.Duration `yaml:"lease"`
+}
@@ -0,0 +1,50 @@
+package config
+
+import "time"
+
+const (
+	ReminderActionReassign = "reassign"
+	ReminderActionEscalate = "escalate"
+)
+
+type ReminderPolicy struct {
+	RemindAfter   time.Duration `yaml:"remind_after"`
+	EscalateAfter time.Duration `yaml:"escalate_after"`
+	Action        string        `yaml:"action"`
+	Lead          string        `yaml:"lead"`
+}
+
+type RemindersConfig struct {
+	CheckInterval  time.Duration `yaml:"check_interval"`
+	ReminderPolicy `yaml:",inline"`
+	Teams          map[string]ReminderPolicy `yaml:"teams"`
+}
+
+func (r *RemindersConfig) PolicyForTeam(teamName string) ReminderPolicy {
+	policy := r.ReminderPolicy
+
+	if teamPolicy, ok := r.Teams[teamName]; ok {
+		if teamPolicy.RemindAfter > 0 {
+			policy.RemindAfter = teamPolicy.RemindAfter
+		}
+		if teamPolicy.EscalateAfter > 0 {
+			policy.EscalateAfter = teamPolicy.EscalateAfter
+		}
+		if teamPolicy.Action != "" {
+			policy.Action = teamPolicy.Action
+		}
+		if teamPolicy.Lead != "" {
+			policy.Lead = teamPolicy.Lead
+		}
+	}
+
+	return policy
+}
+
+func isValidReminderAction(action string) bool {
+	switch action {
+	case ReminderActionReassign, ReminderActionEscalate:
+		return true
+	}
+	return false
+}
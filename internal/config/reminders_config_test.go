@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemindersConfigPolicyForTeam(t *testing.T) {
+	cfg := RemindersConfig{
+		ReminderPolicy: ReminderPolicy{
+			RemindAfter:   24 * time.Hour,
+			EscalateAfter: 72 * time.Hour,
+			Action:        ReminderActionReassign,
+		},
+		Teams: map[string]ReminderPolicy{
+			"payments": {RemindAfter: 4 * time.Hour, EscalateAfter: 8 * time.Hour, Action: ReminderActionEscalate, Lead: "lead1"},
+			"mobile":   {Lead: "lead2"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		team string
+		want ReminderPolicy
+	}{
+		{
+			name: "unknown team uses defaults",
+			team: "backend",
+			want: ReminderPolicy{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour, Action: ReminderActionReassign},
+		},
+		{
+			name: "empty team uses defaults",
+			team: "",
+			want: ReminderPolicy{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour, Action: ReminderActionReassign},
+		},
+		{
+			name: "team overrides every field",
+			team: "payments",
+			want: ReminderPolicy{RemindAfter: 4 * time.Hour, EscalateAfter: 8 * time.Hour, Action: ReminderActionEscalate, Lead: "lead1"},
+		},
+		{
+			name: "zero values keep defaults",
+			team: "mobile",
+			want: ReminderPolicy{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour, Action: ReminderActionReassign, Lead: "lead2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cfg.PolicyForTeam(tt.team))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+const (
+	ReviewerStrategyRandom      = "random"
+	ReviewerStrategyRoundRobin  = "round_robin"
+	ReviewerStrategyLeastLoaded = "least_loaded"
+)
+
+type ReviewersConfig struct {
+	Strategy             string            `yaml:"strategy"`
+	Teams                map[string]string `yaml:"teams"`
+	ReassignOnDeactivate *bool             `yaml:"reassign_on_deactivate"`
+}
+
+func (r *ReviewersConfig) StrategyForTeam(teamName string) string {
+	if strategy, ok := r.Teams[teamName]; ok {
+		return strategy
+	}
+	return r.Strategy
+}
+
+func (r *ReviewersConfig) ShouldReassignOnDeactivate() bool {
+	return r.ReassignOnDeactivate == nil || *r.ReassignOnDeactivate
+}
+
+func isValidReviewerStrategy(strategy string) bool {
+	switch strategy {
+	case ReviewerStrategyRandom, ReviewerStrategyRoundRobin, ReviewerStrategyLeastLoaded:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package config
+
+// SlackRoute picks where a team's notifications go. Routing is done by
+// WebhookURL: app-scoped incoming webhooks post to the channel they were
+// created for and ignore Channel, which only legacy webhooks honour.
+type SlackRoute struct {
+	WebhookURL string `yaml:"webhook_url"`
+	Channel    string `yaml:"channel"`
+}
+
+type SlackConfig struct {
+	WebhookURL string                `yaml:"webhook_url"`
+	Channel    string                `yaml:"channel"`
+	Teams      map[string]SlackRoute `yaml:"teams"`
+	Users      map[string]string     `yaml:"users"`
+}
+
+func (s *SlackConfig) Enabled() bool {
+	if s.WebhookURL != "" {
+		return true
+	}
+	for _, route := range s.Teams {
+		if route.WebhookURL != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SlackConfig) RouteForTeam(teamName string) SlackRoute {
+	route := SlackRoute{WebhookURL: s.WebhookURL, Channel: s.Channel}
+
+	if teamRoute, ok := s.Teams[teamName]; ok {
+		if teamRoute.WebhookURL != "" {
+			route.WebhookURL = teamRoute.WebhookURL
+		}
+		if teamRoute.Channel != "" {
+			route.Channel = teamRoute.Channel
+		}
+	}
+
+	return route
+}
+
+func (s *SlackConfig) MemberIDForUser(userID string) (string, bool) {
+	memberID, ok := s.Users[userID]
+	return memberID, ok
+}
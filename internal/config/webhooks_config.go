@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type WebhooksConfig struct {
+	Timeout        time.Duration `yaml:"timeout"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+func (w *WebhooksConfig) BackoffAfterAttempt(attempt int) time.Duration {
+	backoff := w.InitialBackoff
+	for i := 1; i < attempt && backoff < w.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, w.MaxBackoff)
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+)
+
+type LeaderElector struct {
+	db     *sql.DB
+	logger *slog.Logger
+	cfg    config.LeaderElectionConfig
+
+	mu     sync.Mutex
+	conn   *sql.Conn
+	leader atomic.Bool
+}
+
+func NewLeaderElector(logger *slog.Logger, db *sql.DB, cfg config.LeaderElectionConfig) *LeaderElector {
+	return &LeaderElector{
+		db:     db,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (l *LeaderElector) IsLeader() bool {
+	return l.leader.Load()
+}
+
+func (l *LeaderElector) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(l.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	l.check()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.check()
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (l *LeaderElector) Resign(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	l.leader.Store(false)
+
+	var unlocked bool
+	err := l.conn.QueryRowContext(ctx, `SELECT pg_advisory_unlock($1)`, l.cfg.LockID).Scan(&unlocked)
+	if err != nil {
+		discardConn(l.conn)
+		l.conn = nil
+		return err
+	}
+
+	closeErr := l.conn.Close()
+	l.conn = nil
+
+	l.logger.Info("Resigned leadership", slog.Int64("lock_id", l.cfg.LockID))
+	return closeErr
+}
+
+func (l *LeaderElector) check() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.cfg.CheckInterval)
+	defer cancel()
+
+	if l.conn != nil {
+		err := l.conn.PingContext(ctx)
+		if err == nil {
+			return
+		}
+
+		l.logger.Error("Lost leadership", slog.String("error", err.Error()))
+		l.leader.Store(false)
+		discardConn(l.conn)
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		l.logger.Error("Leader election error", slog.String("error", err.Error()))
+		return
+	}
+
+	var acquired bool
+	if err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.cfg.LockID).Scan(&acquired); err != nil {
+		l.logger.Error("Leader election error", slog.String("error", err.Error()))
+		discardConn(conn)
+		return
+	}
+
+	if !acquired {
+		_ = conn.Close()
+		return
+	}
+
+	l.conn = conn
+	l.leader.Store(true)
+	l.logger.Info("Acquired leadership", slog.Int64("lock_id", l.cfg.LockID))
+}
+
+// discardConn closes the underlying session instead of returning it to the
+// pool. A connection whose state is unknown may still hold the advisory lock,
+// and a pooled session would keep it held long after we gave up leadership.
+func discardConn(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	_ = conn.Close()
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type Absence struct {
+	ID           int        `db:"id"`
+	UserID       string     `db:"user_id"`
+	StartsAt     time.Time  `db:"starts_at"`
+	EndsAt       time.Time  `db:"ends_at"`
+	Reason       string     `db:"reason"`
+	HandedOverAt *time.Time `db:"handed_over_at"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
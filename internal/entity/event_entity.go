@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type EventType string
+
+const (
+	EventReviewerAssigned     EventType = "reviewer.assigned"
+	EventReviewerUnassigned   EventType = "reviewer.unassigned"
+	EventPullRequestMerged    EventType = "pr.merged"
+	EventPullRequestStale     EventType = "pr.stale"
+	EventPullRequestEscalated EventType = "pr.escalated"
+)
+
+type Event struct {
+	ID                int64     `json:"event_id"`
+	Type              EventType `json:"event"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	PullRequestID     string    `json:"pull_request_id"`
+	PullRequestName   string    `json:"pull_request_name"`
+	AuthorID          string    `json:"author_id"`
+	ReviewerID        string    `json:"reviewer_id,omitempty"`
+	OldReviewerID     string    `json:"old_reviewer_id,omitempty"`
+	NewReviewerID     string    `json:"new_reviewer_id,omitempty"`
+	EscalatedTo       string    `json:"escalated_to,omitempty"`
+	AssignedReviewers []string  `json:"assigned_reviewers,omitempty"`
+}
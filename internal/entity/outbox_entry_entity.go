@@ -0,0 +1,10 @@
+package entity
+
+// OutboxEntry is a claimed outbox row: the event plus its delivery state.
+// DeliveredTargets lists the targets that already accepted the event, so a
+// retry only goes to the ones that failed.
+type OutboxEntry struct {
+	Event            Event    `db:"payload"`
+	Attempts         int      `db:"attempts"`
+	DeliveredTargets []string `db:"delivered_targets"`
+}
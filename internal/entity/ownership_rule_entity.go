@@ -0,0 +1,9 @@
+package entity
+
+type OwnershipRule struct {
+	ID       int      `db:"id"`
+	TeamName string   `db:"team_name"`
+	Pattern  string   `db:"pattern"`
+	Owners   []string `db:"owners"`
+	Position int      `db:"position"`
+}
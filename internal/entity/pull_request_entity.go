@@ -3,21 +3,26 @@ package entity
 import "time"
 
 type PullRequest struct {
-	ID                      int               `db:"id"`
-	AuthorID                string            `db:"author_id"`
-	PullRequestID           string            `db:"pull_request_id"`
-	PullRequestName         string            `db:"pull_request_name"`
-	AssignedReviewersFirst  string            `db:"assigned_reviewers_first"`
-	AssignedReviewersSecond string            `db:"assigned_reviewers_second"`
-	Status                  PullRequestStatus `db:"status"`
-	CreatedAt               time.Time         `db:"created_at"`
-	MergedAt                *time.Time        `db:"merged_at"`
-	UpdatedAt               time.Time         `db:"updated_at"`
+	ID                int               `db:"id"`
+	AuthorID          string            `db:"author_id"`
+	PullRequestID     string            `db:"pull_request_id"`
+	PullRequestName   string            `db:"pull_request_name"`
+	AssignedReviewers []string          `db:"assigned_reviewers"`
+	ChangedFiles      []string          `db:"changed_files"`
+	Reviews           []*Review         `db:"-"`
+	ReviewerTeam      string            `db:"-"`
+	Status            PullRequestStatus `db:"status"`
+	CreatedAt         time.Time         `db:"created_at"`
+	MergedAt          *time.Time        `db:"merged_at"`
+	ClosedAt          *time.Time        `db:"closed_at"`
+	UpdatedAt         time.Time         `db:"updated_at"`
 }
 
 type PullRequestStatus string
 
 const (
+	StatusDraft  PullRequestStatus = "DRAFT"
 	StatusOpen   PullRequestStatus = "OPEN"
 	StatusMerged PullRequestStatus = "MERGED"
+	StatusClosed PullRequestStatus = "CLOSED"
 )
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type HistoryAction string
+
+const (
+	HistoryPullRequestCreated HistoryAction = "created"
+	HistoryStatusChanged      HistoryAction = "status_changed"
+	HistoryReviewerReassigned HistoryAction = "reviewer_reassigned"
+	HistoryReviewerRemoved    HistoryAction = "reviewer_removed"
+)
+
+type PullRequestSnapshot struct {
+	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+}
+
+type PullRequestHistoryEntry struct {
+	ID            int64
+	PullRequestID string
+	Action        HistoryAction
+	ActorID       string
+	Before        *PullRequestSnapshot
+	After         *PullRequestSnapshot
+	Reason        string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type Review struct {
+	ID            int           `db:"id"`
+	PullRequestID string        `db:"pull_request_id"`
+	ReviewerID    string        `db:"reviewer_id"`
+	Verdict       ReviewVerdict `db:"verdict"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+type ReviewVerdict string
+
+const (
+	VerdictApprove        ReviewVerdict = "APPROVE"
+	VerdictRequestChanges ReviewVerdict = "REQUEST_CHANGES"
+	VerdictComment        ReviewVerdict = "COMMENT"
+)
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type SilentReview struct {
+	PullRequestID   string        `db:"pull_request_id"`
+	PullRequestName string        `db:"pull_request_name"`
+	AuthorID        string        `db:"author_id"`
+	TeamName        string        `db:"team_name"`
+	ReviewerID      string        `db:"reviewer_id"`
+	Waiting         time.Duration `db:"-"`
+	Reminded        bool          `db:"-"`
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+type StatsFilter struct {
+	TeamName string
+	From     *time.Time
+	To       *time.Time
+}
+
+type ReviewerStats struct {
+	UserID            string
+	Username          string
+	TeamName          string
+	IsActive          bool
+	OpenReviews       int
+	MergedReviews     int
+	ReassignedAway    int
+	MedianTimeToMerge *time.Duration
+}
+
+type TeamWeekStats struct {
+	TeamName          string
+	WeekStart         time.Time
+	Opened            int
+	Merged            int
+	MedianOpenToMerge *time.Duration
+	P90OpenToMerge    *time.Duration
+	OpenedForReview   int
+	UnderReviewed     int
+}
@@ -1,6 +1,13 @@
 package entity
 
+const DefaultRequiredReviewers = 2
+
 type Team struct {
-	ID       int    `db:"id"`
-	TeamName string `db:"team_name"`
+	ID                      int      `db:"id"`
+	TeamName                string   `db:"team_name"`
+	RequiredReviewers       int      `db:"required_reviewers"`
+	RequiredApprovals       int      `db:"required_approvals"`
+	BlockOnChangesRequested bool     `db:"block_on_changes_requested"`
+	AllowSelfApproval       bool     `db:"allow_self_approval"`
+	FallbackTeams           []string `db:"-"`
 }
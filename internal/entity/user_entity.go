@@ -3,11 +3,12 @@ package entity
 import "time"
 
 type User struct {
-	ID        int       `db:"id"`
-	UserID    string    `db:"user_id"`
-	Username  string    `db:"username"`
-	TeamName  string    `db:"team_name"`
-	IsActive  bool      `db:"is_active"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID             int       `db:"id"`
+	UserID         string    `db:"user_id"`
+	Username       string    `db:"username"`
+	TeamName       string    `db:"team_name"`
+	IsActive       bool      `db:"is_active"`
+	MaxOpenReviews *int      `db:"max_open_reviews"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
 }
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+type WebhookSubscription struct {
+	ID        int       `db:"id"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+	Events    []string  `db:"events"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -10,33 +10,106 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	CAPACITYEXCEEDED  ErrorResponseErrorCode = "CAPACITY_EXCEEDED"
+	HASOPENPRS        ErrorResponseErrorCode = "HAS_OPEN_PRS"
+	INVALIDTRANSITION ErrorResponseErrorCode = "INVALID_TRANSITION"
+	MERGEBLOCKED      ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE       ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED       ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND          ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED          ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS          ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED          ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS        ErrorResponseErrorCode = "TEAM_EXISTS"
+	USEREXISTS        ErrorResponseErrorCode = "USER_EXISTS"
+)
+
+// Defines values for PullRequestHistoryEntryAction.
+const (
+	Created            PullRequestHistoryEntryAction = "created"
+	ReviewerReassigned PullRequestHistoryEntryAction = "reviewer_reassigned"
+	ReviewerRemoved    PullRequestHistoryEntryAction = "reviewer_removed"
+	StatusChanged      PullRequestHistoryEntryAction = "status_changed"
+)
+
+// Defines values for PullRequestSnapshotStatus.
+const (
+	PullRequestSnapshotStatusCLOSED PullRequestSnapshotStatus = "CLOSED"
+	PullRequestSnapshotStatusDRAFT  PullRequestSnapshotStatus = "DRAFT"
+	PullRequestSnapshotStatusMERGED PullRequestSnapshotStatus = "MERGED"
+	PullRequestSnapshotStatusOPEN   PullRequestSnapshotStatus = "OPEN"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewReassignmentOutcome.
+const (
+	NoCandidate ReviewReassignmentOutcome = "no_candidate"
+	Reassigned  ReviewReassignmentOutcome = "reassigned"
+	Removed     ReviewReassignmentOutcome = "removed"
+)
+
+// Defines values for ReviewVerdict.
+const (
+	APPROVE        ReviewVerdict = "APPROVE"
+	COMMENT        ReviewVerdict = "COMMENT"
+	REQUESTCHANGES ReviewVerdict = "REQUEST_CHANGES"
+)
+
+// Defines values for WebhookEventType.
+const (
+	PrEscalated        WebhookEventType = "pr.escalated"
+	PrMerged           WebhookEventType = "pr.merged"
+	PrStale            WebhookEventType = "pr.stale"
+	ReviewerAssigned   WebhookEventType = "reviewer.assigned"
+	ReviewerUnassigned WebhookEventType = "reviewer.unassigned"
+)
+
+// Defines values for GetStatsTeamsParamsFormat.
+const (
+	Csv  GetStatsTeamsParamsFormat = "csv"
+	Json GetStatsTeamsParamsFormat = "json"
+)
+
+// Defines values for PostTeamDeleteJSONBodyOpenPrs.
+const (
+	CLOSE  PostTeamDeleteJSONBodyOpenPrs = "CLOSE"
+	REJECT PostTeamDeleteJSONBodyOpenPrs = "REJECT"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	AbsenceId int       `json:"absence_id"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    *string   `json:"reason,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	UserId    string    `json:"user_id"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Error struct {
+	// Details Дополнительные сведения об ошибке (например, невыполненные условия merge)
+	Details *[]string `json:"details,omitempty"`
+	Error   struct {
 		Code    ErrorResponseErrorCode `json:"code"`
 		Message string                 `json:"message"`
 	} `json:"error"`
@@ -45,21 +118,70 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	// Owners user_id владельцев
+	Owners []string `json:"owners"`
+
+	// Pattern Glob-шаблон пути в стиле CODEOWNERS
+	Pattern string `json:"pattern"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..required_reviewers команды автора)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов, переданные при создании PR
+	ChangedFiles    *[]string  `json:"changed_files,omitempty"`
+	ClosedAt        *time.Time `json:"closedAt"`
+	CreatedAt       *time.Time `json:"createdAt"`
+	MergedAt        *time.Time `json:"mergedAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// ReviewerTeam Команда, из которой в этом запросе выбраны ревьюверы (своя или резервная). Не хранится: возвращается только ответами /pullRequest/create, /pullRequest/ready, /pullRequest/reopen и /pullRequest/reassign, если в них выбирались ревьюверы; в остальных ответах отсутствует
+	ReviewerTeam *string              `json:"reviewer_team,omitempty"`
+	Reviews      *[]PullRequestReview `json:"reviews,omitempty"`
+	Status       PullRequestStatus    `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestReview defines model for PullRequestReview.
+type PullRequestReview struct {
+	ReviewerId  string        `json:"reviewer_id"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+	Verdict     ReviewVerdict `json:"verdict"`
+}
+
+// PullRequestHistoryEntry defines model for PullRequestHistoryEntry.
+type PullRequestHistoryEntry struct {
+	Action PullRequestHistoryEntryAction `json:"action"`
+
+	// ActorId Кто выполнил действие: значение заголовка X-Actor-Id (не проверяется сервисом) или system для фоновых задач
+	ActorId   string               `json:"actor_id"`
+	After     *PullRequestSnapshot `json:"after"`
+	Before    *PullRequestSnapshot `json:"before"`
+	CreatedAt time.Time            `json:"created_at"`
+	EventId   int64                `json:"event_id"`
+	Reason    string               `json:"reason"`
+}
+
+// PullRequestHistoryEntryAction defines model for PullRequestHistoryEntry.Action.
+type PullRequestHistoryEntryAction string
+
+// PullRequestSnapshot defines model for PullRequestSnapshot.
+type PullRequestSnapshot struct {
+	AssignedReviewers []string                  `json:"assigned_reviewers"`
+	Status            PullRequestSnapshotStatus `json:"status"`
+}
+
+// PullRequestSnapshotStatus defines model for PullRequestSnapshot.Status.
+type PullRequestSnapshotStatus string
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -73,105 +195,543 @@ type PullRequestShortStatus string
 
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// AllowSelfApproval Засчитывать одобрение автора PR (по умолчанию false)
+	AllowSelfApproval *bool `json:"allow_self_approval,omitempty"`
+
+	// BlockOnChangesRequested Запрещать MERGED, пока у назначенного ревьювера висит REQUEST_CHANGES (по умолчанию false)
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested,omitempty"`
+
+	// FallbackTeams Резервные команды, из которых по порядку берутся ревьюверы, если в своей команде нет кандидатов
+	FallbackTeams *[]string    `json:"fallback_teams,omitempty"`
+	Members       []TeamMember `json:"members"`
+
+	// RequiredApprovals Сколько одобрений нужно для MERGED (0 — без ограничений)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// RequiredReviewers Сколько ревьюверов назначать на PR (по умолчанию 2)
+	RequiredReviewers *int   `json:"required_reviewers,omitempty"`
+	TeamName          string `json:"team_name"`
+}
+
+// ReviewReassignment defines model for ReviewReassignment.
+type ReviewReassignment struct {
+	// NewReviewerId Новый ревьювер; null, если ревьювер не заменён (см. outcome)
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+
+	// Outcome reassigned — ревьюер заменён на new_reviewer_id; removed — подходящих кандидатов не нашлось, и ревьювер снят с PR без замены; no_candidate — подходящих кандидатов не нашлось, и ревьювер остаётся назначенным (временное отсутствие)
+	Outcome       ReviewReassignmentOutcome `json:"outcome"`
+	PullRequestId string                    `json:"pull_request_id"`
+}
+
+// ReviewReassignmentOutcome reassigned — ревьюер заменён на new_reviewer_id; removed — подходящих кандидатов не нашлось, и ревьювер снят с PR без замены; no_candidate — подходящих кандидатов не нашлось, и ревьювер остаётся назначенным (временное отсутствие)
+type ReviewReassignmentOutcome string
+
+// ReviewVerdict defines model for ReviewVerdict.
+type ReviewVerdict string
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	// AssignedMerged Сколько MERGED PR, где пользователь назначен ревьювером
+	AssignedMerged int `json:"assigned_merged"`
+
+	// AssignedOpen Сколько OPEN PR, где пользователь назначен ревьювером
+	AssignedOpen int  `json:"assigned_open"`
+	IsActive     bool `json:"is_active"`
+
+	// MedianTimeToMergeSeconds Медиана времени от создания до merge PR, где пользователь назначен ревьювером (null — таких PR нет)
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds"`
+
+	// ReassignedAway Сколько раз ревью было переназначено с пользователя или снято с него
+	ReassignedAway int    `json:"reassigned_away"`
+	TeamName       string `json:"team_name"`
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+}
+
+// TeamWeekStats defines model for TeamWeekStats.
+type TeamWeekStats struct {
+	// MedianOpenToMergeSeconds Медиана времени от создания до merge для PR, влитых за неделю
+	MedianOpenToMergeSeconds *float64 `json:"median_open_to_merge_seconds"`
+
+	// Merged Сколько PR команды влито за неделю
+	Merged int `json:"merged"`
+
+	// Opened Сколько PR создано авторами команды за неделю
+	Opened int `json:"opened"`
+
+	// P90OpenToMergeSeconds 90-й перцентиль времени от создания до merge для PR, влитых за неделю
+	P90OpenToMergeSeconds *float64 `json:"p90_open_to_merge_seconds"`
+	TeamName              string   `json:"team_name"`
+
+	// UnderReviewedShare Доля PR, созданных не как DRAFT, которым при создании назначено меньше двух ревьюверов
+	UnderReviewedShare *float64 `json:"under_reviewed_share"`
+
+	// WeekStart Понедельник недели
+	WeekStart openapi_types.Date `json:"week_start"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум одновременно открытых ревью (null — без ограничения)
+	MaxOpenReviews *int   `json:"max_open_reviews,omitempty"`
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум одновременно открытых ревью (null — без ограничения)
+	MaxOpenReviews *int   `json:"max_open_reviews,omitempty"`
+	TeamName       string `json:"team_name"`
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	Events         []WebhookEventType `json:"events"`
+	SubscriptionId int                `json:"subscription_id"`
+	Url            string             `json:"url"`
 }
 
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов; владельцы по правилам команды выбираются ревьюверами в первую очередь
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Draft Создать PR в статусе DRAFT без назначения ревьюверов
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 }
 
+// PostPullRequestDraftJSONBody defines parameters for PostPullRequestDraft.
+type PostPullRequestDraftJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
+
+	// Reason Причина переназначения для журнала изменений PR
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	PullRequestId string        `json:"pull_request_id"`
+	ReviewerId    string        `json:"reviewer_id"`
+	Verdict       ReviewVerdict `json:"verdict"`
+}
+
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	// TeamName Только участники команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// TeamName Только одна команда
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Формат ответа; по умолчанию определяется заголовком Accept
+	Format *GetStatsTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetStatsTeamsParamsFormat defines parameters for GetStatsTeams.
+type GetStatsTeamsParamsFormat string
+
+// PostTeamAddMembersJSONBody defines parameters for PostTeamAddMembers.
+type PostTeamAddMembersJSONBody struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	// OpenPrs Что делать с OPEN/DRAFT PR участников команды (по умолчанию REJECT — отказать в удалении)
+	OpenPrs  *PostTeamDeleteJSONBodyOpenPrs `json:"open_prs,omitempty"`
+	TeamName string                         `json:"team_name"`
+}
+
+// PostTeamDeleteJSONBodyOpenPrs defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBodyOpenPrs string
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamGetOwnershipRulesParams defines parameters for GetTeamGetOwnershipRules.
+type GetTeamGetOwnershipRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamImportCodeownersJSONBody defines parameters for PostTeamImportCodeowners.
+type PostTeamImportCodeownersJSONBody struct {
+	// Content Содержимое файла CODEOWNERS
+	Content  string `json:"content"`
+	TeamName string `json:"team_name"`
+}
+
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamSetOwnershipRulesJSONBody defines parameters for PostTeamSetOwnershipRules.
+type PostTeamSetOwnershipRulesJSONBody struct {
+	Rules    []OwnershipRule `json:"rules"`
+	TeamName string          `json:"team_name"`
+}
+
+// PostTeamUpdateJSONBody defines parameters for PostTeamUpdate.
+type PostTeamUpdateJSONBody struct {
+	AllowSelfApproval       *bool     `json:"allow_self_approval,omitempty"`
+	BlockOnChangesRequested *bool     `json:"block_on_changes_requested,omitempty"`
+	FallbackTeams           *[]string `json:"fallback_teams,omitempty"`
+	NewTeamName             *string   `json:"new_team_name,omitempty"`
+	RequiredApprovals       *int      `json:"required_approvals,omitempty"`
+	RequiredReviewers       *int      `json:"required_reviewers,omitempty"`
+	TeamName                string    `json:"team_name"`
+}
+
+// PostUsersAddAbsenceJSONBody defines parameters for PostUsersAddAbsence.
+type PostUsersAddAbsenceJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostUsersBulkDeactivateJSONBody defines parameters for PostUsersBulkDeactivate.
+type PostUsersBulkDeactivateJSONBody struct {
+	// DryRun Только посчитать изменения, ничего не сохраняя
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// TeamName Деактивировать всех участников команды
+	TeamName *string `json:"team_name,omitempty"`
+
+	// UserIds Деактивировать перечисленных пользователей
+	UserIds *[]string `json:"user_ids,omitempty"`
+}
+
+// PostUsersDeleteAbsenceJSONBody defines parameters for PostUsersDeleteAbsence.
+type PostUsersDeleteAbsenceJSONBody struct {
+	AbsenceId int `json:"absence_id"`
+}
+
+// GetUsersGetAbsencesParams defines parameters for GetUsersGetAbsences.
+type GetUsersGetAbsencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersMoveTeamJSONBody defines parameters for PostUsersMoveTeam.
+type PostUsersMoveTeamJSONBody struct {
+	// TeamName Новая команда пользователя
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+	IsActive bool `json:"is_active"`
+
+	// ReassignReviews Переназначить ревью в OPEN PR при деактивации (по умолчанию reviewers.reassign_on_deactivate из конфигурации)
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
+
+	// Reason Причина изменения для журнала изменений PR, затронутых переназначением
+	Reason *string `json:"reason,omitempty"`
+	UserId string  `json:"user_id"`
+}
+
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersUpdateAbsenceJSONBody defines parameters for PostUsersUpdateAbsence.
+type PostUsersUpdateAbsenceJSONBody struct {
+	AbsenceId int        `json:"absence_id"`
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+	Reason    *string    `json:"reason,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+}
+
+// PostWebhooksAddSubscriptionJSONBody defines parameters for PostWebhooksAddSubscription.
+type PostWebhooksAddSubscriptionJSONBody struct {
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Секрет для подписи доставок; в ответах не возвращается
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// PostWebhooksDeleteSubscriptionJSONBody defines parameters for PostWebhooksDeleteSubscription.
+type PostWebhooksDeleteSubscriptionJSONBody struct {
+	SubscriptionId int `json:"subscription_id"`
 }
 
+// PostWebhooksGithubJSONBody defines parameters for PostWebhooksGithub.
+type PostWebhooksGithubJSONBody = map[string]interface{}
+
+// PostWebhooksGitlabJSONBody defines parameters for PostWebhooksGitlab.
+type PostWebhooksGitlabJSONBody = map[string]interface{}
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
+// PostPullRequestDraftJSONRequestBody defines body for PostPullRequestDraft for application/json ContentType.
+type PostPullRequestDraftJSONRequestBody PostPullRequestDraftJSONBody
+
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamAddMembersJSONRequestBody defines body for PostTeamAddMembers for application/json ContentType.
+type PostTeamAddMembersJSONRequestBody PostTeamAddMembersJSONBody
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamImportCodeownersJSONRequestBody defines body for PostTeamImportCodeowners for application/json ContentType.
+type PostTeamImportCodeownersJSONRequestBody PostTeamImportCodeownersJSONBody
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
+
+// PostTeamSetOwnershipRulesJSONRequestBody defines body for PostTeamSetOwnershipRules for application/json ContentType.
+type PostTeamSetOwnershipRulesJSONRequestBody PostTeamSetOwnershipRulesJSONBody
+
+// PostTeamUpdateJSONRequestBody defines body for PostTeamUpdate for application/json ContentType.
+type PostTeamUpdateJSONRequestBody PostTeamUpdateJSONBody
+
+// PostUsersAddAbsenceJSONRequestBody defines body for PostUsersAddAbsence for application/json ContentType.
+type PostUsersAddAbsenceJSONRequestBody PostUsersAddAbsenceJSONBody
+
+// PostUsersBulkDeactivateJSONRequestBody defines body for PostUsersBulkDeactivate for application/json ContentType.
+type PostUsersBulkDeactivateJSONRequestBody PostUsersBulkDeactivateJSONBody
+
+// PostUsersDeleteAbsenceJSONRequestBody defines body for PostUsersDeleteAbsence for application/json ContentType.
+type PostUsersDeleteAbsenceJSONRequestBody PostUsersDeleteAbsenceJSONBody
+
+// PostUsersMoveTeamJSONRequestBody defines body for PostUsersMoveTeam for application/json ContentType.
+type PostUsersMoveTeamJSONRequestBody PostUsersMoveTeamJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersUpdateAbsenceJSONRequestBody defines body for PostUsersUpdateAbsence for application/json ContentType.
+type PostUsersUpdateAbsenceJSONRequestBody PostUsersUpdateAbsenceJSONBody
+
+// PostWebhooksAddSubscriptionJSONRequestBody defines body for PostWebhooksAddSubscription for application/json ContentType.
+type PostWebhooksAddSubscriptionJSONRequestBody PostWebhooksAddSubscriptionJSONBody
+
+// PostWebhooksDeleteSubscriptionJSONRequestBody defines body for PostWebhooksDeleteSubscription for application/json ContentType.
+type PostWebhooksDeleteSubscriptionJSONRequestBody PostWebhooksDeleteSubscriptionJSONBody
+
+// PostWebhooksGithubJSONRequestBody defines body for PostWebhooksGithub for application/json ContentType.
+type PostWebhooksGithubJSONRequestBody = PostWebhooksGithubJSONBody
+
+// PostWebhooksGitlabJSONRequestBody defines body for PostWebhooksGitlab for application/json ContentType.
+type PostWebhooksGitlabJSONRequestBody = PostWebhooksGitlabJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Закрыть PR без слияния (CLOSED)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx echo.Context) error
+	// Создать PR и автоматически назначить до required_reviewers ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Вернуть OPEN PR в DRAFT
+	// (POST /pullRequest/draft)
+	PostPullRequestDraft(ctx echo.Context) error
+	// Получить журнал изменений PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
+	// Перевести DRAFT PR в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx echo.Context) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
+	// Переоткрыть CLOSED PR
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx echo.Context) error
+	// Оставить вердикт назначенного ревьювера
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx echo.Context) error
+	// Получить статистику нагрузки ревьюверов
+	// (GET /stats/reviewers)
+	GetStatsReviewers(ctx echo.Context, params GetStatsReviewersParams) error
+	// Получить недельную статистику потока PR по командам
+	// (GET /stats/teams)
+	GetStatsTeams(ctx echo.Context, params GetStatsTeamsParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
+	// Добавить новых участников в команду
+	// (POST /team/addMembers)
+	PostTeamAddMembers(ctx echo.Context) error
+	// Удалить команду (участники открепляются и деактивируются)
+	// (POST /team/delete)
+	PostTeamDelete(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Получить правила владения кодом команды
+	// (GET /team/getOwnershipRules)
+	GetTeamGetOwnershipRules(ctx echo.Context, params GetTeamGetOwnershipRulesParams) error
+	// Импортировать правила владения кодом команды из файла CODEOWNERS
+	// (POST /team/importCodeowners)
+	PostTeamImportCodeowners(ctx echo.Context) error
+	// Открепить участника от команды и деактивировать его
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx echo.Context) error
+	// Заменить правила владения кодом команды
+	// (POST /team/setOwnershipRules)
+	PostTeamSetOwnershipRules(ctx echo.Context) error
+	// Переименовать команду и/или изменить её настройки
+	// (POST /team/update)
+	PostTeamUpdate(ctx echo.Context) error
+	// Запланировать отсутствие пользователя
+	// (POST /users/addAbsence)
+	PostUsersAddAbsence(ctx echo.Context) error
+	// Массово деактивировать пользователей с переназначением их ревью
+	// (POST /users/bulkDeactivate)
+	PostUsersBulkDeactivate(ctx echo.Context) error
+	// Удалить запланированное отсутствие
+	// (POST /users/deleteAbsence)
+	PostUsersDeleteAbsence(ctx echo.Context) error
+	// Получить отсутствия пользователя
+	// (GET /users/getAbsences)
+	GetUsersGetAbsences(ctx echo.Context, params GetUsersGetAbsencesParams) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
+	// Перевести пользователя в другую команду с передачей его открытых ревью
+	// (POST /users/moveTeam)
+	PostUsersMoveTeam(ctx echo.Context) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Установить лимит одновременно открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx echo.Context) error
+	// Изменить запланированное отсутствие
+	// (POST /users/updateAbsence)
+	PostUsersUpdateAbsence(ctx echo.Context) error
+	// Зарегистрировать исходящий webhook
+	// (POST /webhooks/addSubscription)
+	PostWebhooksAddSubscription(ctx echo.Context) error
+	// Удалить исходящий webhook
+	// (POST /webhooks/deleteSubscription)
+	PostWebhooksDeleteSubscription(ctx echo.Context) error
+	// Список исходящих webhook
+	// (GET /webhooks/getSubscriptions)
+	GetWebhooksGetSubscriptions(ctx echo.Context) error
+	// Принять webhook pull_request от GitHub
+	// (POST /webhooks/github)
+	PostWebhooksGithub(ctx echo.Context) error
+	// Принять Merge Request Hook от GitLab
+	// (POST /webhooks/gitlab)
+	PostWebhooksGitlab(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -179,6 +739,15 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// PostPullRequestClose converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestClose(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestClose(ctx)
+	return err
+}
+
 // PostPullRequestCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
@@ -188,6 +757,33 @@ func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	return err
 }
 
+// PostPullRequestDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestDraft(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestDraft(ctx)
+	return err
+}
+
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
@@ -197,6 +793,15 @@ func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	return err
 }
 
+// PostPullRequestReady converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReady(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReady(ctx)
+	return err
+}
+
 // PostPullRequestReassign converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
 	var err error
@@ -206,6 +811,95 @@ func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error
 	return err
 }
 
+// PostPullRequestReopen converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReopen(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReopen(ctx)
+	return err
+}
+
+// PostPullRequestReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReview(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReview(ctx)
+	return err
+}
+
+// GetStatsReviewers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsReviewers(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewersParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsReviewers(ctx, params)
+	return err
+}
+
+// GetStatsTeams converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsTeams(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsTeams(ctx, params)
+	return err
+}
+
 // PostTeamAdd converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
@@ -215,6 +909,24 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamAddMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamAddMembers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamAddMembers(ctx)
+	return err
+}
+
+// PostTeamDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamDelete(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamDelete(ctx)
+	return err
+}
+
 // GetTeamGet converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
@@ -233,6 +945,105 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// GetTeamGetOwnershipRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamGetOwnershipRules(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetOwnershipRulesParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamGetOwnershipRules(ctx, params)
+	return err
+}
+
+// PostTeamImportCodeowners converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamImportCodeowners(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamImportCodeowners(ctx)
+	return err
+}
+
+// PostTeamRemoveMember converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRemoveMember(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRemoveMember(ctx)
+	return err
+}
+
+// PostTeamSetOwnershipRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetOwnershipRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetOwnershipRules(ctx)
+	return err
+}
+
+// PostTeamUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamUpdate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamUpdate(ctx)
+	return err
+}
+
+// PostUsersAddAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAddAbsence(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAddAbsence(ctx)
+	return err
+}
+
+// PostUsersBulkDeactivate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersBulkDeactivate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersBulkDeactivate(ctx)
+	return err
+}
+
+// PostUsersDeleteAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersDeleteAbsence(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersDeleteAbsence(ctx)
+	return err
+}
+
+// GetUsersGetAbsences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetAbsences(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetAbsencesParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetAbsences(ctx, params)
+	return err
+}
+
 // GetUsersGetReview converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
@@ -251,6 +1062,15 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	return err
 }
 
+// PostUsersMoveTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersMoveTeam(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersMoveTeam(ctx)
+	return err
+}
+
 // PostUsersSetIsActive converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
@@ -260,6 +1080,69 @@ func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	return err
 }
 
+// PostUsersSetMaxOpenReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetMaxOpenReviews(ctx)
+	return err
+}
+
+// PostUsersUpdateAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUpdateAbsence(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUpdateAbsence(ctx)
+	return err
+}
+
+// PostWebhooksAddSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksAddSubscription(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksAddSubscription(ctx)
+	return err
+}
+
+// PostWebhooksDeleteSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksDeleteSubscription(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksDeleteSubscription(ctx)
+	return err
+}
+
+// GetWebhooksGetSubscriptions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksGetSubscriptions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksGetSubscriptions(ctx)
+	return err
+}
+
+// PostWebhooksGithub converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksGithub(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksGithub(ctx)
+	return err
+}
+
+// PostWebhooksGitlab converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksGitlab(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksGitlab(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -288,12 +1171,39 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(baseURL+"/pullRequest/draft", wrapper.PostPullRequestDraft)
+	router.GET(baseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(baseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(baseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	router.GET(baseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	router.GET(baseURL+"/stats/teams", wrapper.GetStatsTeams)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(baseURL+"/team/addMembers", wrapper.PostTeamAddMembers)
+	router.POST(baseURL+"/team/delete", wrapper.PostTeamDelete)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(baseURL+"/team/getOwnershipRules", wrapper.GetTeamGetOwnershipRules)
+	router.POST(baseURL+"/team/importCodeowners", wrapper.PostTeamImportCodeowners)
+	router.POST(baseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	router.POST(baseURL+"/team/setOwnershipRules", wrapper.PostTeamSetOwnershipRules)
+	router.POST(baseURL+"/team/update", wrapper.PostTeamUpdate)
+	router.POST(baseURL+"/users/addAbsence", wrapper.PostUsersAddAbsence)
+	router.POST(baseURL+"/users/bulkDeactivate", wrapper.PostUsersBulkDeactivate)
+	router.POST(baseURL+"/users/deleteAbsence", wrapper.PostUsersDeleteAbsence)
+	router.GET(baseURL+"/users/getAbsences", wrapper.GetUsersGetAbsences)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.POST(baseURL+"/users/moveTeam", wrapper.PostUsersMoveTeam)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	router.POST(baseURL+"/users/updateAbsence", wrapper.PostUsersUpdateAbsence)
+	router.POST(baseURL+"/webhooks/addSubscription", wrapper.PostWebhooksAddSubscription)
+	router.POST(baseURL+"/webhooks/deleteSubscription", wrapper.PostWebhooksDeleteSubscription)
+	router.GET(baseURL+"/webhooks/getSubscriptions", wrapper.GetWebhooksGetSubscriptions)
+	router.POST(baseURL+"/webhooks/github", wrapper.PostWebhooksGithub)
+	router.POST(baseURL+"/webhooks/gitlab", wrapper.PostWebhooksGitlab)
 
 }
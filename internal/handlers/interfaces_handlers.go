@@ -9,6 +9,11 @@ import (
 type PullRequestService interface {
 	CreatePullRequest(ctx echo.Context) error
 	MergePullRequest(ctx echo.Context) error
+	ClosePullRequest(ctx echo.Context) error
+	ReopenPullRequest(ctx echo.Context) error
+	MarkPullRequestReady(ctx echo.Context) error
+	MarkPullRequestDraft(ctx echo.Context) error
+	ReviewPullRequest(ctx echo.Context) error
 	ReassignPullRequestReviewer(ctx echo.Context) error
 	GetUserReviewRequests(ctx echo.Context, params api.GetUsersGetReviewParams) error
 }
@@ -16,14 +21,37 @@ type PullRequestService interface {
 type TeamService interface {
 	CreateTeam(ctx echo.Context) error
 	GetTeam(ctx echo.Context, params api.GetTeamGetParams) error
+	UpdateTeam(ctx echo.Context) error
+	AddTeamMembers(ctx echo.Context) error
+	RemoveTeamMember(ctx echo.Context) error
+	DeleteTeam(ctx echo.Context) error
+	GetOwnershipRules(ctx echo.Context) error
+	SetOwnershipRules(ctx echo.Context) error
+	ImportCodeowners(ctx echo.Context) error
 }
 
 type UserService interface {
 	SetUserActive(ctx echo.Context) error
+	MoveUserToTeam(ctx echo.Context) error
+	BulkDeactivateUsers(ctx echo.Context) error
+	SetMaxOpenReviews(ctx echo.Context) error
+	AddAbsence(ctx echo.Context) error
+	GetAbsences(ctx echo.Context) error
+	UpdateAbsence(ctx echo.Context) error
+	DeleteAbsence(ctx echo.Context) error
+}
+
+type WebhookService interface {
+	GitHubWebhook(ctx echo.Context) error
+	GitLabWebhook(ctx echo.Context) error
+	AddSubscription(ctx echo.Context) error
+	GetSubscriptions(ctx echo.Context) error
+	DeleteSubscription(ctx echo.Context) error
 }
 
 type ServiceHandler interface {
 	PullRequestService
 	TeamService
 	UserService
+	WebhookService
 }
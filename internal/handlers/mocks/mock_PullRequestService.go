@@ -25,6 +25,65 @@ func (_m *MockPullRequestService) EXPECT() *MockPullRequestService_Expecter {
 	return &MockPullRequestService_Expecter{mock: &_m.Mock}
 }
 
+// ClosePullRequest provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) ClosePullRequest(ctx context.Context, req *api.PostPullRequestCloseJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePullRequest")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestCloseJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestCloseJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestCloseJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_ClosePullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClosePullRequest'
+type MockPullRequestService_ClosePullRequest_Call struct {
+	*mock.Call
+}
+
+// ClosePullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestCloseJSONRequestBody
+func (_e *MockPullRequestService_Expecter) ClosePullRequest(ctx interface{}, req interface{}) *MockPullRequestService_ClosePullRequest_Call {
+	return &MockPullRequestService_ClosePullRequest_Call{Call: _e.mock.On("ClosePullRequest", ctx, req)}
+}
+
+func (_c *MockPullRequestService_ClosePullRequest_Call) Run(run func(ctx context.Context, req *api.PostPullRequestCloseJSONRequestBody)) *MockPullRequestService_ClosePullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestCloseJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_ClosePullRequest_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_ClosePullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_ClosePullRequest_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestCloseJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_ClosePullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePullRequest provides a mock function with given fields: ctx, req
 func (_m *MockPullRequestService) CreatePullRequest(ctx context.Context, req *api.PostPullRequestCreateJSONRequestBody) (*models.PullRequest, error) {
 	ret := _m.Called(ctx, req)
@@ -84,6 +143,65 @@ func (_c *MockPullRequestService_CreatePullRequest_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetPullRequestHistory provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) GetPullRequestHistory(ctx context.Context, req *api.GetPullRequestHistoryParams) ([]models.PullRequestHistory, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestHistory")
+	}
+
+	var r0 []models.PullRequestHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetPullRequestHistoryParams) ([]models.PullRequestHistory, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetPullRequestHistoryParams) []models.PullRequestHistory); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PullRequestHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.GetPullRequestHistoryParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_GetPullRequestHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestHistory'
+type MockPullRequestService_GetPullRequestHistory_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.GetPullRequestHistoryParams
+func (_e *MockPullRequestService_Expecter) GetPullRequestHistory(ctx interface{}, req interface{}) *MockPullRequestService_GetPullRequestHistory_Call {
+	return &MockPullRequestService_GetPullRequestHistory_Call{Call: _e.mock.On("GetPullRequestHistory", ctx, req)}
+}
+
+func (_c *MockPullRequestService_GetPullRequestHistory_Call) Run(run func(ctx context.Context, req *api.GetPullRequestHistoryParams)) *MockPullRequestService_GetPullRequestHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.GetPullRequestHistoryParams))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_GetPullRequestHistory_Call) Return(_a0 []models.PullRequestHistory, _a1 error) *MockPullRequestService_GetPullRequestHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_GetPullRequestHistory_Call) RunAndReturn(run func(context.Context, *api.GetPullRequestHistoryParams) ([]models.PullRequestHistory, error)) *MockPullRequestService_GetPullRequestHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserReviewRequests provides a mock function with given fields: ctx, req
 func (_m *MockPullRequestService) GetUserReviewRequests(ctx context.Context, req *api.GetUsersGetReviewParams) ([]*models.PullRequestShort, error) {
 	ret := _m.Called(ctx, req)
@@ -143,6 +261,124 @@ func (_c *MockPullRequestService_GetUserReviewRequests_Call) RunAndReturn(run fu
 	return _c
 }
 
+// MarkPullRequestDraft provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) MarkPullRequestDraft(ctx context.Context, req *api.PostPullRequestDraftJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPullRequestDraft")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestDraftJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestDraftJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestDraftJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_MarkPullRequestDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPullRequestDraft'
+type MockPullRequestService_MarkPullRequestDraft_Call struct {
+	*mock.Call
+}
+
+// MarkPullRequestDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestDraftJSONRequestBody
+func (_e *MockPullRequestService_Expecter) MarkPullRequestDraft(ctx interface{}, req interface{}) *MockPullRequestService_MarkPullRequestDraft_Call {
+	return &MockPullRequestService_MarkPullRequestDraft_Call{Call: _e.mock.On("MarkPullRequestDraft", ctx, req)}
+}
+
+func (_c *MockPullRequestService_MarkPullRequestDraft_Call) Run(run func(ctx context.Context, req *api.PostPullRequestDraftJSONRequestBody)) *MockPullRequestService_MarkPullRequestDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestDraftJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_MarkPullRequestDraft_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_MarkPullRequestDraft_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_MarkPullRequestDraft_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestDraftJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_MarkPullRequestDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPullRequestReady provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) MarkPullRequestReady(ctx context.Context, req *api.PostPullRequestReadyJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPullRequestReady")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReadyJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReadyJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestReadyJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_MarkPullRequestReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPullRequestReady'
+type MockPullRequestService_MarkPullRequestReady_Call struct {
+	*mock.Call
+}
+
+// MarkPullRequestReady is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestReadyJSONRequestBody
+func (_e *MockPullRequestService_Expecter) MarkPullRequestReady(ctx interface{}, req interface{}) *MockPullRequestService_MarkPullRequestReady_Call {
+	return &MockPullRequestService_MarkPullRequestReady_Call{Call: _e.mock.On("MarkPullRequestReady", ctx, req)}
+}
+
+func (_c *MockPullRequestService_MarkPullRequestReady_Call) Run(run func(ctx context.Context, req *api.PostPullRequestReadyJSONRequestBody)) *MockPullRequestService_MarkPullRequestReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestReadyJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_MarkPullRequestReady_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_MarkPullRequestReady_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_MarkPullRequestReady_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestReadyJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_MarkPullRequestReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MergePullRequest provides a mock function with given fields: ctx, req
 func (_m *MockPullRequestService) MergePullRequest(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error) {
 	ret := _m.Called(ctx, req)
@@ -268,6 +504,183 @@ func (_c *MockPullRequestService_ReassignReviewer_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// RecordExternalMerge provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) RecordExternalMerge(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordExternalMerge")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestMergeJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestMergeJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_RecordExternalMerge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordExternalMerge'
+type MockPullRequestService_RecordExternalMerge_Call struct {
+	*mock.Call
+}
+
+// RecordExternalMerge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestMergeJSONRequestBody
+func (_e *MockPullRequestService_Expecter) RecordExternalMerge(ctx interface{}, req interface{}) *MockPullRequestService_RecordExternalMerge_Call {
+	return &MockPullRequestService_RecordExternalMerge_Call{Call: _e.mock.On("RecordExternalMerge", ctx, req)}
+}
+
+func (_c *MockPullRequestService_RecordExternalMerge_Call) Run(run func(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody)) *MockPullRequestService_RecordExternalMerge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestMergeJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_RecordExternalMerge_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_RecordExternalMerge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_RecordExternalMerge_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_RecordExternalMerge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenPullRequest provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) ReopenPullRequest(ctx context.Context, req *api.PostPullRequestReopenJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenPullRequest")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReopenJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReopenJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestReopenJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_ReopenPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenPullRequest'
+type MockPullRequestService_ReopenPullRequest_Call struct {
+	*mock.Call
+}
+
+// ReopenPullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestReopenJSONRequestBody
+func (_e *MockPullRequestService_Expecter) ReopenPullRequest(ctx interface{}, req interface{}) *MockPullRequestService_ReopenPullRequest_Call {
+	return &MockPullRequestService_ReopenPullRequest_Call{Call: _e.mock.On("ReopenPullRequest", ctx, req)}
+}
+
+func (_c *MockPullRequestService_ReopenPullRequest_Call) Run(run func(ctx context.Context, req *api.PostPullRequestReopenJSONRequestBody)) *MockPullRequestService_ReopenPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestReopenJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_ReopenPullRequest_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_ReopenPullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_ReopenPullRequest_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestReopenJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_ReopenPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReviewPullRequest provides a mock function with given fields: ctx, req
+func (_m *MockPullRequestService) ReviewPullRequest(ctx context.Context, req *api.PostPullRequestReviewJSONRequestBody) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewPullRequest")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReviewJSONRequestBody) (*models.PullRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostPullRequestReviewJSONRequestBody) *models.PullRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostPullRequestReviewJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestService_ReviewPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewPullRequest'
+type MockPullRequestService_ReviewPullRequest_Call struct {
+	*mock.Call
+}
+
+// ReviewPullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostPullRequestReviewJSONRequestBody
+func (_e *MockPullRequestService_Expecter) ReviewPullRequest(ctx interface{}, req interface{}) *MockPullRequestService_ReviewPullRequest_Call {
+	return &MockPullRequestService_ReviewPullRequest_Call{Call: _e.mock.On("ReviewPullRequest", ctx, req)}
+}
+
+func (_c *MockPullRequestService_ReviewPullRequest_Call) Run(run func(ctx context.Context, req *api.PostPullRequestReviewJSONRequestBody)) *MockPullRequestService_ReviewPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostPullRequestReviewJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestService_ReviewPullRequest_Call) Return(_a0 *models.PullRequest, _a1 error) *MockPullRequestService_ReviewPullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPullRequestService_ReviewPullRequest_Call) RunAndReturn(run func(context.Context, *api.PostPullRequestReviewJSONRequestBody) (*models.PullRequest, error)) *MockPullRequestService_ReviewPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPullRequestService creates a new instance of MockPullRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPullRequestService(t interface {
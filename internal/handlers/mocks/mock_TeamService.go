@@ -25,6 +25,65 @@ func (_m *MockTeamService) EXPECT() *MockTeamService_Expecter {
 	return &MockTeamService_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMembers provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) AddTeamMembers(ctx context.Context, req *api.PostTeamAddMembersJSONRequestBody) (*models.Team, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMembers")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamAddMembersJSONRequestBody) (*models.Team, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamAddMembersJSONRequestBody) *models.Team); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamAddMembersJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamService_AddTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMembers'
+type MockTeamService_AddTeamMembers_Call struct {
+	*mock.Call
+}
+
+// AddTeamMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamAddMembersJSONRequestBody
+func (_e *MockTeamService_Expecter) AddTeamMembers(ctx interface{}, req interface{}) *MockTeamService_AddTeamMembers_Call {
+	return &MockTeamService_AddTeamMembers_Call{Call: _e.mock.On("AddTeamMembers", ctx, req)}
+}
+
+func (_c *MockTeamService_AddTeamMembers_Call) Run(run func(ctx context.Context, req *api.PostTeamAddMembersJSONRequestBody)) *MockTeamService_AddTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamAddMembersJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_AddTeamMembers_Call) Return(_a0 *models.Team, _a1 error) *MockTeamService_AddTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamService_AddTeamMembers_Call) RunAndReturn(run func(context.Context, *api.PostTeamAddMembersJSONRequestBody) (*models.Team, error)) *MockTeamService_AddTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTeam provides a mock function with given fields: ctx, team
 func (_m *MockTeamService) CreateTeam(ctx context.Context, team *api.Team) (*models.Team, error) {
 	ret := _m.Called(ctx, team)
@@ -84,6 +143,133 @@ func (_c *MockTeamService_CreateTeam_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteTeam provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) DeleteTeam(ctx context.Context, req *api.PostTeamDeleteJSONRequestBody) ([]string, []models.ReviewReassignment, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 []string
+	var r1 []models.ReviewReassignment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamDeleteJSONRequestBody) ([]string, []models.ReviewReassignment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamDeleteJSONRequestBody) []string); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamDeleteJSONRequestBody) []models.ReviewReassignment); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ReviewReassignment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *api.PostTeamDeleteJSONRequestBody) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockTeamService_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MockTeamService_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamDeleteJSONRequestBody
+func (_e *MockTeamService_Expecter) DeleteTeam(ctx interface{}, req interface{}) *MockTeamService_DeleteTeam_Call {
+	return &MockTeamService_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, req)}
+}
+
+func (_c *MockTeamService_DeleteTeam_Call) Run(run func(ctx context.Context, req *api.PostTeamDeleteJSONRequestBody)) *MockTeamService_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamDeleteJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_DeleteTeam_Call) Return(_a0 []string, _a1 []models.ReviewReassignment, _a2 error) *MockTeamService_DeleteTeam_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTeamService_DeleteTeam_Call) RunAndReturn(run func(context.Context, *api.PostTeamDeleteJSONRequestBody) ([]string, []models.ReviewReassignment, error)) *MockTeamService_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOwnershipRules provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) GetOwnershipRules(ctx context.Context, req *api.GetTeamGetOwnershipRulesParams) ([]models.OwnershipRule, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnershipRules")
+	}
+
+	var r0 []models.OwnershipRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetTeamGetOwnershipRulesParams) ([]models.OwnershipRule, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetTeamGetOwnershipRulesParams) []models.OwnershipRule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OwnershipRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.GetTeamGetOwnershipRulesParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamService_GetOwnershipRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwnershipRules'
+type MockTeamService_GetOwnershipRules_Call struct {
+	*mock.Call
+}
+
+// GetOwnershipRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.GetTeamGetOwnershipRulesParams
+func (_e *MockTeamService_Expecter) GetOwnershipRules(ctx interface{}, req interface{}) *MockTeamService_GetOwnershipRules_Call {
+	return &MockTeamService_GetOwnershipRules_Call{Call: _e.mock.On("GetOwnershipRules", ctx, req)}
+}
+
+func (_c *MockTeamService_GetOwnershipRules_Call) Run(run func(ctx context.Context, req *api.GetTeamGetOwnershipRulesParams)) *MockTeamService_GetOwnershipRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.GetTeamGetOwnershipRulesParams))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_GetOwnershipRules_Call) Return(_a0 []models.OwnershipRule, _a1 error) *MockTeamService_GetOwnershipRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamService_GetOwnershipRules_Call) RunAndReturn(run func(context.Context, *api.GetTeamGetOwnershipRulesParams) ([]models.OwnershipRule, error)) *MockTeamService_GetOwnershipRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeam provides a mock function with given fields: ctx, req
 func (_m *MockTeamService) GetTeam(ctx context.Context, req *api.GetTeamGetParams) (*models.Team, error) {
 	ret := _m.Called(ctx, req)
@@ -143,6 +329,251 @@ func (_c *MockTeamService_GetTeam_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ImportCodeowners provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) ImportCodeowners(ctx context.Context, req *api.PostTeamImportCodeownersJSONRequestBody) ([]models.OwnershipRule, []string, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCodeowners")
+	}
+
+	var r0 []models.OwnershipRule
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamImportCodeownersJSONRequestBody) ([]models.OwnershipRule, []string, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamImportCodeownersJSONRequestBody) []models.OwnershipRule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OwnershipRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamImportCodeownersJSONRequestBody) []string); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *api.PostTeamImportCodeownersJSONRequestBody) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockTeamService_ImportCodeowners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportCodeowners'
+type MockTeamService_ImportCodeowners_Call struct {
+	*mock.Call
+}
+
+// ImportCodeowners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamImportCodeownersJSONRequestBody
+func (_e *MockTeamService_Expecter) ImportCodeowners(ctx interface{}, req interface{}) *MockTeamService_ImportCodeowners_Call {
+	return &MockTeamService_ImportCodeowners_Call{Call: _e.mock.On("ImportCodeowners", ctx, req)}
+}
+
+func (_c *MockTeamService_ImportCodeowners_Call) Run(run func(ctx context.Context, req *api.PostTeamImportCodeownersJSONRequestBody)) *MockTeamService_ImportCodeowners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamImportCodeownersJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_ImportCodeowners_Call) Return(_a0 []models.OwnershipRule, _a1 []string, _a2 error) *MockTeamService_ImportCodeowners_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTeamService_ImportCodeowners_Call) RunAndReturn(run func(context.Context, *api.PostTeamImportCodeownersJSONRequestBody) ([]models.OwnershipRule, []string, error)) *MockTeamService_ImportCodeowners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) RemoveTeamMember(ctx context.Context, req *api.PostTeamRemoveMemberJSONRequestBody) (*models.Team, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamRemoveMemberJSONRequestBody) (*models.Team, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamRemoveMemberJSONRequestBody) *models.Team); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamRemoveMemberJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamService_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type MockTeamService_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamRemoveMemberJSONRequestBody
+func (_e *MockTeamService_Expecter) RemoveTeamMember(ctx interface{}, req interface{}) *MockTeamService_RemoveTeamMember_Call {
+	return &MockTeamService_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", ctx, req)}
+}
+
+func (_c *MockTeamService_RemoveTeamMember_Call) Run(run func(ctx context.Context, req *api.PostTeamRemoveMemberJSONRequestBody)) *MockTeamService_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamRemoveMemberJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_RemoveTeamMember_Call) Return(_a0 *models.Team, _a1 error) *MockTeamService_RemoveTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamService_RemoveTeamMember_Call) RunAndReturn(run func(context.Context, *api.PostTeamRemoveMemberJSONRequestBody) (*models.Team, error)) *MockTeamService_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOwnershipRules provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) SetOwnershipRules(ctx context.Context, req *api.PostTeamSetOwnershipRulesJSONRequestBody) ([]models.OwnershipRule, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOwnershipRules")
+	}
+
+	var r0 []models.OwnershipRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamSetOwnershipRulesJSONRequestBody) ([]models.OwnershipRule, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamSetOwnershipRulesJSONRequestBody) []models.OwnershipRule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OwnershipRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamSetOwnershipRulesJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamService_SetOwnershipRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOwnershipRules'
+type MockTeamService_SetOwnershipRules_Call struct {
+	*mock.Call
+}
+
+// SetOwnershipRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamSetOwnershipRulesJSONRequestBody
+func (_e *MockTeamService_Expecter) SetOwnershipRules(ctx interface{}, req interface{}) *MockTeamService_SetOwnershipRules_Call {
+	return &MockTeamService_SetOwnershipRules_Call{Call: _e.mock.On("SetOwnershipRules", ctx, req)}
+}
+
+func (_c *MockTeamService_SetOwnershipRules_Call) Run(run func(ctx context.Context, req *api.PostTeamSetOwnershipRulesJSONRequestBody)) *MockTeamService_SetOwnershipRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamSetOwnershipRulesJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_SetOwnershipRules_Call) Return(_a0 []models.OwnershipRule, _a1 error) *MockTeamService_SetOwnershipRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamService_SetOwnershipRules_Call) RunAndReturn(run func(context.Context, *api.PostTeamSetOwnershipRulesJSONRequestBody) ([]models.OwnershipRule, error)) *MockTeamService_SetOwnershipRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeam provides a mock function with given fields: ctx, req
+func (_m *MockTeamService) UpdateTeam(ctx context.Context, req *api.PostTeamUpdateJSONRequestBody) (*models.Team, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeam")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamUpdateJSONRequestBody) (*models.Team, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostTeamUpdateJSONRequestBody) *models.Team); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostTeamUpdateJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamService_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type MockTeamService_UpdateTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostTeamUpdateJSONRequestBody
+func (_e *MockTeamService_Expecter) UpdateTeam(ctx interface{}, req interface{}) *MockTeamService_UpdateTeam_Call {
+	return &MockTeamService_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, req)}
+}
+
+func (_c *MockTeamService_UpdateTeam_Call) Run(run func(ctx context.Context, req *api.PostTeamUpdateJSONRequestBody)) *MockTeamService_UpdateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostTeamUpdateJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockTeamService_UpdateTeam_Call) Return(_a0 *models.Team, _a1 error) *MockTeamService_UpdateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamService_UpdateTeam_Call) RunAndReturn(run func(context.Context, *api.PostTeamUpdateJSONRequestBody) (*models.Team, error)) *MockTeamService_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTeamService creates a new instance of MockTeamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTeamService(t interface {
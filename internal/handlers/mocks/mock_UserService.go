@@ -25,20 +25,379 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
-// SetUserActive provides a mock function with given fields: ctx, req
-func (_m *MockUserService) SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, error) {
+// AddAbsence provides a mock function with given fields: ctx, req
+func (_m *MockUserService) AddAbsence(ctx context.Context, req *api.PostUsersAddAbsenceJSONRequestBody) (*models.Absence, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetUserActive")
+		panic("no return value specified for AddAbsence")
+	}
+
+	var r0 *models.Absence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersAddAbsenceJSONRequestBody) (*models.Absence, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersAddAbsenceJSONRequestBody) *models.Absence); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Absence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersAddAbsenceJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_AddAbsence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAbsence'
+type MockUserService_AddAbsence_Call struct {
+	*mock.Call
+}
+
+// AddAbsence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersAddAbsenceJSONRequestBody
+func (_e *MockUserService_Expecter) AddAbsence(ctx interface{}, req interface{}) *MockUserService_AddAbsence_Call {
+	return &MockUserService_AddAbsence_Call{Call: _e.mock.On("AddAbsence", ctx, req)}
+}
+
+func (_c *MockUserService_AddAbsence_Call) Run(run func(ctx context.Context, req *api.PostUsersAddAbsenceJSONRequestBody)) *MockUserService_AddAbsence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersAddAbsenceJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_AddAbsence_Call) Return(_a0 *models.Absence, _a1 error) *MockUserService_AddAbsence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_AddAbsence_Call) RunAndReturn(run func(context.Context, *api.PostUsersAddAbsenceJSONRequestBody) (*models.Absence, error)) *MockUserService_AddAbsence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkDeactivate provides a mock function with given fields: ctx, req
+func (_m *MockUserService) BulkDeactivate(ctx context.Context, req *api.PostUsersBulkDeactivateJSONRequestBody) ([]*models.User, []models.ReviewReassignment, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkDeactivate")
+	}
+
+	var r0 []*models.User
+	var r1 []models.ReviewReassignment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersBulkDeactivateJSONRequestBody) ([]*models.User, []models.ReviewReassignment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersBulkDeactivateJSONRequestBody) []*models.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersBulkDeactivateJSONRequestBody) []models.ReviewReassignment); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ReviewReassignment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *api.PostUsersBulkDeactivateJSONRequestBody) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserService_BulkDeactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDeactivate'
+type MockUserService_BulkDeactivate_Call struct {
+	*mock.Call
+}
+
+// BulkDeactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersBulkDeactivateJSONRequestBody
+func (_e *MockUserService_Expecter) BulkDeactivate(ctx interface{}, req interface{}) *MockUserService_BulkDeactivate_Call {
+	return &MockUserService_BulkDeactivate_Call{Call: _e.mock.On("BulkDeactivate", ctx, req)}
+}
+
+func (_c *MockUserService_BulkDeactivate_Call) Run(run func(ctx context.Context, req *api.PostUsersBulkDeactivateJSONRequestBody)) *MockUserService_BulkDeactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersBulkDeactivateJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_BulkDeactivate_Call) Return(_a0 []*models.User, _a1 []models.ReviewReassignment, _a2 error) *MockUserService_BulkDeactivate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserService_BulkDeactivate_Call) RunAndReturn(run func(context.Context, *api.PostUsersBulkDeactivateJSONRequestBody) ([]*models.User, []models.ReviewReassignment, error)) *MockUserService_BulkDeactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAbsence provides a mock function with given fields: ctx, req
+func (_m *MockUserService) DeleteAbsence(ctx context.Context, req *api.PostUsersDeleteAbsenceJSONRequestBody) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAbsence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersDeleteAbsenceJSONRequestBody) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_DeleteAbsence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAbsence'
+type MockUserService_DeleteAbsence_Call struct {
+	*mock.Call
+}
+
+// DeleteAbsence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersDeleteAbsenceJSONRequestBody
+func (_e *MockUserService_Expecter) DeleteAbsence(ctx interface{}, req interface{}) *MockUserService_DeleteAbsence_Call {
+	return &MockUserService_DeleteAbsence_Call{Call: _e.mock.On("DeleteAbsence", ctx, req)}
+}
+
+func (_c *MockUserService_DeleteAbsence_Call) Run(run func(ctx context.Context, req *api.PostUsersDeleteAbsenceJSONRequestBody)) *MockUserService_DeleteAbsence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersDeleteAbsenceJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_DeleteAbsence_Call) Return(_a0 error) *MockUserService_DeleteAbsence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_DeleteAbsence_Call) RunAndReturn(run func(context.Context, *api.PostUsersDeleteAbsenceJSONRequestBody) error) *MockUserService_DeleteAbsence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAbsences provides a mock function with given fields: ctx, req
+func (_m *MockUserService) GetAbsences(ctx context.Context, req *api.GetUsersGetAbsencesParams) ([]*models.Absence, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAbsences")
+	}
+
+	var r0 []*models.Absence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetUsersGetAbsencesParams) ([]*models.Absence, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.GetUsersGetAbsencesParams) []*models.Absence); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Absence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.GetUsersGetAbsencesParams) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_GetAbsences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAbsences'
+type MockUserService_GetAbsences_Call struct {
+	*mock.Call
+}
+
+// GetAbsences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.GetUsersGetAbsencesParams
+func (_e *MockUserService_Expecter) GetAbsences(ctx interface{}, req interface{}) *MockUserService_GetAbsences_Call {
+	return &MockUserService_GetAbsences_Call{Call: _e.mock.On("GetAbsences", ctx, req)}
+}
+
+func (_c *MockUserService_GetAbsences_Call) Run(run func(ctx context.Context, req *api.GetUsersGetAbsencesParams)) *MockUserService_GetAbsences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.GetUsersGetAbsencesParams))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetAbsences_Call) Return(_a0 []*models.Absence, _a1 error) *MockUserService_GetAbsences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_GetAbsences_Call) RunAndReturn(run func(context.Context, *api.GetUsersGetAbsencesParams) ([]*models.Absence, error)) *MockUserService_GetAbsences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandOverStartedAbsences provides a mock function with given fields: ctx
+func (_m *MockUserService) HandOverStartedAbsences(ctx context.Context) ([]models.ReviewReassignment, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandOverStartedAbsences")
+	}
+
+	var r0 []models.ReviewReassignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReviewReassignment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReviewReassignment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewReassignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_HandOverStartedAbsences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandOverStartedAbsences'
+type MockUserService_HandOverStartedAbsences_Call struct {
+	*mock.Call
+}
+
+// HandOverStartedAbsences is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserService_Expecter) HandOverStartedAbsences(ctx interface{}) *MockUserService_HandOverStartedAbsences_Call {
+	return &MockUserService_HandOverStartedAbsences_Call{Call: _e.mock.On("HandOverStartedAbsences", ctx)}
+}
+
+func (_c *MockUserService_HandOverStartedAbsences_Call) Run(run func(ctx context.Context)) *MockUserService_HandOverStartedAbsences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserService_HandOverStartedAbsences_Call) Return(_a0 []models.ReviewReassignment, _a1 error) *MockUserService_HandOverStartedAbsences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_HandOverStartedAbsences_Call) RunAndReturn(run func(context.Context) ([]models.ReviewReassignment, error)) *MockUserService_HandOverStartedAbsences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveUserToTeam provides a mock function with given fields: ctx, req
+func (_m *MockUserService) MoveUserToTeam(ctx context.Context, req *api.PostUsersMoveTeamJSONRequestBody) (*models.User, []models.ReviewReassignment, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveUserToTeam")
+	}
+
+	var r0 *models.User
+	var r1 []models.ReviewReassignment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersMoveTeamJSONRequestBody) (*models.User, []models.ReviewReassignment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersMoveTeamJSONRequestBody) *models.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersMoveTeamJSONRequestBody) []models.ReviewReassignment); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ReviewReassignment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *api.PostUsersMoveTeamJSONRequestBody) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserService_MoveUserToTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveUserToTeam'
+type MockUserService_MoveUserToTeam_Call struct {
+	*mock.Call
+}
+
+// MoveUserToTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersMoveTeamJSONRequestBody
+func (_e *MockUserService_Expecter) MoveUserToTeam(ctx interface{}, req interface{}) *MockUserService_MoveUserToTeam_Call {
+	return &MockUserService_MoveUserToTeam_Call{Call: _e.mock.On("MoveUserToTeam", ctx, req)}
+}
+
+func (_c *MockUserService_MoveUserToTeam_Call) Run(run func(ctx context.Context, req *api.PostUsersMoveTeamJSONRequestBody)) *MockUserService_MoveUserToTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersMoveTeamJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_MoveUserToTeam_Call) Return(_a0 *models.User, _a1 []models.ReviewReassignment, _a2 error) *MockUserService_MoveUserToTeam_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserService_MoveUserToTeam_Call) RunAndReturn(run func(context.Context, *api.PostUsersMoveTeamJSONRequestBody) (*models.User, []models.ReviewReassignment, error)) *MockUserService_MoveUserToTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaxOpenReviews provides a mock function with given fields: ctx, req
+func (_m *MockUserService) SetMaxOpenReviews(ctx context.Context, req *api.PostUsersSetMaxOpenReviewsJSONRequestBody) (*models.User, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaxOpenReviews")
 	}
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetMaxOpenReviewsJSONRequestBody) (*models.User, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) *models.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetMaxOpenReviewsJSONRequestBody) *models.User); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
@@ -46,7 +405,7 @@ func (_m *MockUserService) SetUserActive(ctx context.Context, req *api.PostUsers
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersSetMaxOpenReviewsJSONRequestBody) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -55,6 +414,74 @@ func (_m *MockUserService) SetUserActive(ctx context.Context, req *api.PostUsers
 	return r0, r1
 }
 
+// MockUserService_SetMaxOpenReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaxOpenReviews'
+type MockUserService_SetMaxOpenReviews_Call struct {
+	*mock.Call
+}
+
+// SetMaxOpenReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersSetMaxOpenReviewsJSONRequestBody
+func (_e *MockUserService_Expecter) SetMaxOpenReviews(ctx interface{}, req interface{}) *MockUserService_SetMaxOpenReviews_Call {
+	return &MockUserService_SetMaxOpenReviews_Call{Call: _e.mock.On("SetMaxOpenReviews", ctx, req)}
+}
+
+func (_c *MockUserService_SetMaxOpenReviews_Call) Run(run func(ctx context.Context, req *api.PostUsersSetMaxOpenReviewsJSONRequestBody)) *MockUserService_SetMaxOpenReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersSetMaxOpenReviewsJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_SetMaxOpenReviews_Call) Return(_a0 *models.User, _a1 error) *MockUserService_SetMaxOpenReviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_SetMaxOpenReviews_Call) RunAndReturn(run func(context.Context, *api.PostUsersSetMaxOpenReviewsJSONRequestBody) (*models.User, error)) *MockUserService_SetMaxOpenReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserActive provides a mock function with given fields: ctx, req
+func (_m *MockUserService) SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, []models.ReviewReassignment, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserActive")
+	}
+
+	var r0 *models.User
+	var r1 []models.ReviewReassignment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, []models.ReviewReassignment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) *models.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) []models.ReviewReassignment); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.ReviewReassignment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // MockUserService_SetUserActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserActive'
 type MockUserService_SetUserActive_Call struct {
 	*mock.Call
@@ -74,12 +501,71 @@ func (_c *MockUserService_SetUserActive_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockUserService_SetUserActive_Call) Return(_a0 *models.User, _a1 error) *MockUserService_SetUserActive_Call {
+func (_c *MockUserService_SetUserActive_Call) Return(_a0 *models.User, _a1 []models.ReviewReassignment, _a2 error) *MockUserService_SetUserActive_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserService_SetUserActive_Call) RunAndReturn(run func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, []models.ReviewReassignment, error)) *MockUserService_SetUserActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAbsence provides a mock function with given fields: ctx, req
+func (_m *MockUserService) UpdateAbsence(ctx context.Context, req *api.PostUsersUpdateAbsenceJSONRequestBody) (*models.Absence, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAbsence")
+	}
+
+	var r0 *models.Absence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersUpdateAbsenceJSONRequestBody) (*models.Absence, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PostUsersUpdateAbsenceJSONRequestBody) *models.Absence); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Absence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PostUsersUpdateAbsenceJSONRequestBody) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_UpdateAbsence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAbsence'
+type MockUserService_UpdateAbsence_Call struct {
+	*mock.Call
+}
+
+// UpdateAbsence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *api.PostUsersUpdateAbsenceJSONRequestBody
+func (_e *MockUserService_Expecter) UpdateAbsence(ctx interface{}, req interface{}) *MockUserService_UpdateAbsence_Call {
+	return &MockUserService_UpdateAbsence_Call{Call: _e.mock.On("UpdateAbsence", ctx, req)}
+}
+
+func (_c *MockUserService_UpdateAbsence_Call) Run(run func(ctx context.Context, req *api.PostUsersUpdateAbsenceJSONRequestBody)) *MockUserService_UpdateAbsence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.PostUsersUpdateAbsenceJSONRequestBody))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateAbsence_Call) Return(_a0 *models.Absence, _a1 error) *MockUserService_UpdateAbsence_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUserService_SetUserActive_Call) RunAndReturn(run func(context.Context, *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, error)) *MockUserService_SetUserActive_Call {
+func (_c *MockUserService_UpdateAbsence_Call) RunAndReturn(run func(context.Context, *api.PostUsersUpdateAbsenceJSONRequestBody) (*models.Absence, error)) *MockUserService_UpdateAbsence_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -31,6 +31,18 @@ func (s *Server) PostPullRequestCreate(ctx echo.Context) error {
 			})
 		}
 
+		if errors.Is(err, service.ErrReviewerCapacityExceeded) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.CAPACITYEXCEEDED,
+					Message: err.Error(),
+				},
+			})
+		}
+
 		if errors.Is(err, service.ErrPullRequestExists) {
 			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
 				Error: struct {
@@ -57,6 +69,80 @@ func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 
 	pr, err := s.PullRequestService.MergePullRequest(ctx.Request().Context(), &body)
 	if err != nil {
+		return s.pullRequestStatusError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pr)
+}
+
+func (s *Server) PostPullRequestClose(ctx echo.Context) error {
+	var body api.PostPullRequestCloseJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.PullRequestService.ClosePullRequest(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.pullRequestStatusError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pr)
+}
+
+func (s *Server) PostPullRequestReopen(ctx echo.Context) error {
+	var body api.PostPullRequestReopenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.PullRequestService.ReopenPullRequest(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.pullRequestStatusError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pr)
+}
+
+func (s *Server) PostPullRequestReady(ctx echo.Context) error {
+	var body api.PostPullRequestReadyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.PullRequestService.MarkPullRequestReady(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.pullRequestStatusError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pr)
+}
+
+func (s *Server) PostPullRequestDraft(ctx echo.Context) error {
+	var body api.PostPullRequestDraftJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.PullRequestService.MarkPullRequestDraft(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.pullRequestStatusError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pr)
+}
+
+func (s *Server) PostPullRequestReview(ctx echo.Context) error {
+	var body api.PostPullRequestReviewJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.PullRequestService.ReviewPullRequest(ctx.Request().Context(), &body)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidVerdict) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
 		if errors.Is(err, sql.ErrNoRows) {
 			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
 				Error: struct {
@@ -69,12 +155,102 @@ func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 			})
 		}
 
+		if errors.Is(err, service.ErrPullRequestMerged) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.PRMERGED,
+					Message: "cannot review merged PR",
+				},
+			})
+		}
+
+		if errors.Is(err, service.ErrPullRequestClosed) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.PRCLOSED,
+					Message: "cannot review closed PR",
+				},
+			})
+		}
+
+		if errors.Is(err, service.ErrPullRequestNotAsigned) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTASSIGNED,
+					Message: "reviewer is not assigned to this PR",
+				},
+			})
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return ctx.JSON(http.StatusOK, pr)
 }
 
+func (s *Server) pullRequestStatusError(ctx echo.Context, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: "resource not found",
+			},
+		})
+	}
+
+	var blocked *service.MergeBlockedError
+	if errors.As(err, &blocked) {
+		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.MERGEBLOCKED,
+				Message: service.ErrPullRequestMergeBlocked.Error(),
+			},
+			Details: &blocked.Conditions,
+		})
+	}
+
+	if errors.Is(err, service.ErrReviewerCapacityExceeded) {
+		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.CAPACITYEXCEEDED,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	if errors.Is(err, service.ErrPullRequestInvalidTransition) {
+		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.INVALIDTRANSITION,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
+
 func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 	var body api.PostPullRequestReassignJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -107,6 +283,18 @@ func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 			})
 		}
 
+		if errors.Is(err, service.ErrPullRequestClosed) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.PRCLOSED,
+					Message: "cannot reassign on closed PR",
+				},
+			})
+		}
+
 		if errors.Is(err, service.ErrPullRequestNotAsigned) {
 			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
 				Error: struct {
@@ -119,6 +307,18 @@ func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 			})
 		}
 
+		if errors.Is(err, service.ErrReviewerCapacityExceeded) {
+			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.CAPACITYEXCEEDED,
+					Message: err.Error(),
+				},
+			})
+		}
+
 		if errors.Is(err, service.ErrPullRequestNoCandidate) {
 			return ctx.JSON(http.StatusConflict, api.ErrorResponse{
 				Error: struct {
@@ -163,3 +363,27 @@ func (s *Server) GetUsersGetReview(ctx echo.Context, params api.GetUsersGetRevie
 		"pull_requests": pullRequests,
 	})
 }
+
+func (s *Server) GetPullRequestHistory(ctx echo.Context, params api.GetPullRequestHistoryParams) error {
+	history, err := s.PullRequestService.GetPullRequestHistory(ctx.Request().Context(), &params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTFOUND,
+					Message: "resource not found",
+				},
+			})
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"history":         history,
+	})
+}
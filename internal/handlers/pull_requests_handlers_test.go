@@ -1,6 +1,8 @@
 package handlers_test
 
 import (
+	"database/sql"
+	"fmt"
 	"github.com/oooooorg/PR-Service/internal/service"
 	"net/http"
 	"net/http/httptest"
@@ -101,6 +103,43 @@ func TestPostPullRequestCreate_Conflict(t *testing.T) {
 	pullRequestServiceMock.AssertExpectations(t)
 }
 
+func TestPostPullRequestCreate_CapacityExceeded(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "pull_request_id": "pr-1002",
+        "pull_request_name": "Add filters",
+        "author_id": "u1"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"CreatePullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestCreateJSONRequestBody"),
+		).
+		Return(
+			(*models.PullRequest)(nil),
+			service.ErrReviewerCapacityExceeded,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestCreate(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"CAPACITY_EXCEEDED"`)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
 func TestPostPullRequestMerge_Success(t *testing.T) {
 	e := echo.New()
 
@@ -180,3 +219,389 @@ func TestPostPullRequestReassign_Success(t *testing.T) {
 	assert.Equal(t, http.StatusOK, recorder.Code)
 	pullRequestServiceMock.AssertExpectations(t)
 }
+
+func TestPostPullRequestClose_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{"pull_request_id": "pr-1001"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/close", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"ClosePullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestCloseJSONRequestBody"),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "pr-1001",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            api.PullRequestStatusCLOSED,
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestClose(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestReopen_InvalidTransition(t *testing.T) {
+	e := echo.New()
+
+	body := `{"pull_request_id": "pr-1001"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/reopen", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"ReopenPullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestReopenJSONRequestBody"),
+		).
+		Return(
+			(*models.PullRequest)(nil),
+			service.ErrPullRequestInvalidTransition,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestReopen(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.INVALIDTRANSITION))
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestReady_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{"pull_request_id": "pr-1001"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/ready", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"MarkPullRequestReady",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestReadyJSONRequestBody"),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "pr-1001",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            api.PullRequestStatusOPEN,
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestReady(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestDraft_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{"pull_request_id": "pr-1001"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/draft", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"MarkPullRequestDraft",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestDraftJSONRequestBody"),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "pr-1001",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            api.PullRequestStatusDRAFT,
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestDraft(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestReview_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "pull_request_id": "pr-1001",
+        "reviewer_id": "u2",
+        "verdict": "APPROVE"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/review", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"ReviewPullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestReviewJSONRequestBody"),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "pr-1001",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            api.PullRequestStatusOPEN,
+				AssignedReviewers: []string{"u2", "u3"},
+				Reviews: &[]models.PullRequestReview{
+					{ReviewerId: "u2", Verdict: api.APPROVE},
+				},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestReview(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestReview_NotAssigned(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "pull_request_id": "pr-1001",
+        "reviewer_id": "u9",
+        "verdict": "REQUEST_CHANGES"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/review", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"ReviewPullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestReviewJSONRequestBody"),
+		).
+		Return(
+			(*models.PullRequest)(nil),
+			service.ErrPullRequestNotAsigned,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestReview(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestReview_InvalidVerdict(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "pull_request_id": "pr-1001",
+        "reviewer_id": "u2",
+        "verdict": "LGTM"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/review", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"ReviewPullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestReviewJSONRequestBody"),
+		).
+		Return(
+			(*models.PullRequest)(nil),
+			fmt.Errorf("%w: LGTM", service.ErrInvalidVerdict),
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestReview(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostPullRequestMerge_Blocked(t *testing.T) {
+	e := echo.New()
+
+	body := `{"pull_request_id": "pr-1001"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"MergePullRequest",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostPullRequestMergeJSONRequestBody"),
+		).
+		Return(
+			(*models.PullRequest)(nil),
+			&service.MergeBlockedError{Conditions: []string{"1 of 2 required approvals", "changes requested by u3"}},
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.PostPullRequestMerge(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.MERGEBLOCKED))
+	assert.Contains(t, recorder.Body.String(), "changes requested by u3")
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestGetPullRequestHistory_Success(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr-1001", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"GetPullRequestHistory",
+			mock.Anything,
+			&api.GetPullRequestHistoryParams{PullRequestId: "pr-1001"},
+		).
+		Return(
+			[]models.PullRequestHistory{
+				{
+					EventId: 1,
+					Action:  api.Created,
+					ActorId: "u1",
+					After: &models.PullRequestSnapshot{
+						Status:            api.PullRequestSnapshotStatusOPEN,
+						AssignedReviewers: []string{"u2", "u3"},
+					},
+					Reason: "pull request created",
+				},
+				{
+					EventId: 2,
+					Action:  api.ReviewerReassigned,
+					ActorId: "u7",
+					Before: &models.PullRequestSnapshot{
+						Status:            api.PullRequestSnapshotStatusOPEN,
+						AssignedReviewers: []string{"u2", "u3"},
+					},
+					After: &models.PullRequestSnapshot{
+						Status:            api.PullRequestSnapshotStatusOPEN,
+						AssignedReviewers: []string{"u5", "u3"},
+					},
+					Reason: "u2 is on vacation",
+				},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.GetPullRequestHistory(ctx, api.GetPullRequestHistoryParams{PullRequestId: "pr-1001"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"before":null`)
+	assert.Contains(t, recorder.Body.String(), `"actor_id":"u7"`)
+	assert.Contains(t, recorder.Body.String(), "reviewer_reassigned")
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestGetPullRequestHistory_NotFound(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr-404", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"GetPullRequestHistory",
+			mock.Anything,
+			mock.AnythingOfType("*api.GetPullRequestHistoryParams"),
+		).
+		Return(
+			([]models.PullRequestHistory)(nil),
+			sql.ErrNoRows,
+		)
+
+	serverMock := newTestServerPullRequest(pullRequestServiceMock)
+
+	err := serverMock.GetPullRequestHistory(ctx, api.GetPullRequestHistoryParams{PullRequestId: "pr-404"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.NOTFOUND))
+	pullRequestServiceMock.AssertExpectations(t)
+}
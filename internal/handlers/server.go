@@ -10,25 +10,48 @@ import (
 )
 
 type Server struct {
-	db                 *sql.DB
-	cfg                *config.Config
-	PullRequestService service.PullRequestService
-	TeamService        service.TeamService
-	UserService        service.UserService
-	logger             *slog.Logger
+	db                         *sql.DB
+	cfg                        *config.Config
+	PullRequestService         service.PullRequestService
+	TeamService                service.TeamService
+	UserService                service.UserService
+	GitHubWebhookService       service.GitHubWebhookService
+	GitLabWebhookService       service.GitLabWebhookService
+	WebhookSubscriptionService service.WebhookSubscriptionService
+	OutboxDispatcher           *service.OutboxDispatcher
+	ReviewReminderService      service.ReviewReminderService
+	StatsService               service.StatsService
+	logger                     *slog.Logger
 }
 
 func NewServer(logger *slog.Logger, db *sql.DB, cfg *config.Config) *Server {
 	userRepository := repository.NewUserRepository(db)
 	teamRepository := repository.NewTeamRepository(db)
 	pullRequestRepository := repository.NewPullRequestRepository(db)
+	reviewRepository := repository.NewReviewRepository(db)
+	absenceRepository := repository.NewAbsenceRepository(db)
+	webhookRepository := repository.NewWebhookRepository(db)
+	outboxRepository := repository.NewOutboxRepository(db)
+	historyRepository := repository.NewPullRequestHistoryRepository(db)
+	httpDeliverer := service.NewHTTPDeliverer(logger, cfg.Webhooks)
+	webhookDispatcher := service.NewWebhookDispatcher(logger, webhookRepository)
+	slackNotifier := service.NewSlackNotifier(logger, userRepository, cfg.Slack)
+	reviewerSelectors := service.NewReviewerSelectors(cfg.Reviewers, pullRequestRepository, teamRepository)
+	reviewerAssigner := service.NewReviewerAssigner(userRepository, pullRequestRepository, absenceRepository, reviewerSelectors)
+	pullRequestService := service.NewPullRequestService(logger, pullRequestRepository, userRepository, teamRepository, reviewRepository, reviewerAssigner, outboxRepository, historyRepository)
 
 	return &Server{
-		logger:             logger,
-		db:                 db,
-		cfg:                cfg,
-		PullRequestService: service.NewPullRequestService(logger, pullRequestRepository, userRepository, teamRepository),
-		TeamService:        service.NewTeamService(logger, userRepository, teamRepository),
-		UserService:        service.NewUserService(logger, userRepository, teamRepository),
+		logger:                     logger,
+		db:                         db,
+		cfg:                        cfg,
+		PullRequestService:         pullRequestService,
+		TeamService:                service.NewTeamService(logger, userRepository, teamRepository, pullRequestRepository, reviewerAssigner, outboxRepository, historyRepository),
+		UserService:                service.NewUserService(logger, userRepository, teamRepository, pullRequestRepository, reviewerAssigner, absenceRepository, outboxRepository, historyRepository, cfg.Reviewers),
+		GitHubWebhookService:       service.NewGitHubWebhookService(logger, pullRequestService, cfg.GitHub),
+		GitLabWebhookService:       service.NewGitLabWebhookService(logger, pullRequestService, cfg.GitLab),
+		WebhookSubscriptionService: service.NewWebhookSubscriptionService(logger, webhookRepository),
+		OutboxDispatcher:           service.NewOutboxDispatcher(logger, outboxRepository, httpDeliverer, cfg.Outbox, cfg.Webhooks, webhookDispatcher, slackNotifier),
+		StatsService:               service.NewStatsService(logger, repository.NewStatsRepository(db), teamRepository),
+		ReviewReminderService:      service.NewReviewReminderService(logger, pullRequestRepository, teamRepository, reviewerAssigner, outboxRepository, historyRepository, cfg.Reminders),
 	}
 }
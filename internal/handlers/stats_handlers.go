@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/service"
+)
+
+func (s *Server) GetStatsReviewers(ctx echo.Context, params api.GetStatsReviewersParams) error {
+	reviewers, err := s.StatsService.GetReviewerStats(ctx.Request().Context(), &params)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name": params.TeamName,
+		"from":      params.From,
+		"to":        params.To,
+		"reviewers": reviewers,
+	})
+}
+
+func (s *Server) GetStatsTeams(ctx echo.Context, params api.GetStatsTeamsParams) error {
+	teams, err := s.StatsService.GetTeamStats(ctx.Request().Context(), &params)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	if wantsCSV(ctx, params.Format) {
+		return writeTeamStatsCSV(ctx, teams)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name": params.TeamName,
+		"from":      params.From,
+		"to":        params.To,
+		"teams":     teams,
+	})
+}
+
+func wantsCSV(ctx echo.Context, format *api.GetStatsTeamsParamsFormat) bool {
+	if format != nil {
+		return *format == api.Csv
+	}
+	return strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), "text/csv")
+}
+
+func writeTeamStatsCSV(ctx echo.Context, teams []models.TeamWeekStats) error {
+	ctx.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="team-stats.csv"`)
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(ctx.Response())
+	if err := writer.Write([]string{
+		"team_name", "week_start", "opened", "merged",
+		"median_open_to_merge_seconds", "p90_open_to_merge_seconds", "under_reviewed_share",
+	}); err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		if err := writer.Write([]string{
+			csvText(team.TeamName),
+			team.WeekStart.String(),
+			strconv.Itoa(team.Opened),
+			strconv.Itoa(team.Merged),
+			formatOptionalFloat(team.MedianOpenToMergeSeconds),
+			formatOptionalFloat(team.P90OpenToMergeSeconds),
+			formatOptionalFloat(team.UnderReviewedShare),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvText keeps spreadsheets from evaluating a cell as a formula by prefixing
+// values that start with a formula character with a quote.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func formatOptionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func statsError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrTeamNotFound):
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: "resource not found",
+			},
+		})
+	case errors.Is(err, service.ErrInvalidStatsRange):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,259 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/handlers"
+	"github.com/oooooorg/PR-Service/internal/handlers/mocks"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/service"
+)
+
+func newTestServerStats(statsServiceMock *mocks.MockStatsService) *handlers.Server {
+	return &handlers.Server{
+		StatsService: statsServiceMock,
+	}
+}
+
+func TestGetStatsReviewers_Success(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/stats/reviewers?team_name=backend", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamName := "backend"
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	median := 86400.0
+	params := api.GetStatsReviewersParams{TeamName: &teamName, From: &from}
+
+	statsServiceMock := new(mocks.MockStatsService)
+
+	statsServiceMock.
+		On("GetReviewerStats", mock.Anything, &params).
+		Return(
+			[]models.ReviewerStats{
+				{
+					UserId:                   "u2",
+					Username:                 "Bob",
+					TeamName:                 "backend",
+					IsActive:                 true,
+					AssignedOpen:             3,
+					AssignedMerged:           12,
+					ReassignedAway:           1,
+					MedianTimeToMergeSeconds: &median,
+				},
+				{
+					UserId:   "u3",
+					Username: "Carol",
+					TeamName: "backend",
+					IsActive: true,
+				},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerStats(statsServiceMock)
+
+	err := serverMock.GetStatsReviewers(ctx, params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"assigned_merged":12`)
+	assert.Contains(t, recorder.Body.String(), `"median_time_to_merge_seconds":86400`)
+	assert.Contains(t, recorder.Body.String(), `"median_time_to_merge_seconds":null`)
+	statsServiceMock.AssertExpectations(t)
+}
+
+func TestGetStatsReviewers_TeamNotFound(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/stats/reviewers?team_name=unknown", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	statsServiceMock := new(mocks.MockStatsService)
+
+	statsServiceMock.
+		On("GetReviewerStats", mock.Anything, mock.AnythingOfType("*api.GetStatsReviewersParams")).
+		Return(([]models.ReviewerStats)(nil), service.ErrTeamNotFound)
+
+	serverMock := newTestServerStats(statsServiceMock)
+
+	teamName := "unknown"
+	err := serverMock.GetStatsReviewers(ctx, api.GetStatsReviewersParams{TeamName: &teamName})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.NOTFOUND))
+	statsServiceMock.AssertExpectations(t)
+}
+
+func TestGetStatsReviewers_InvalidRange(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/stats/reviewers", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	statsServiceMock := new(mocks.MockStatsService)
+
+	statsServiceMock.
+		On("GetReviewerStats", mock.Anything, mock.AnythingOfType("*api.GetStatsReviewersParams")).
+		Return(([]models.ReviewerStats)(nil), service.ErrInvalidStatsRange)
+
+	serverMock := newTestServerStats(statsServiceMock)
+
+	from := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	err := serverMock.GetStatsReviewers(ctx, api.GetStatsReviewersParams{From: &from, To: &to})
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	statsServiceMock.AssertExpectations(t)
+}
+
+func teamWeekStatsFixture() []models.TeamWeekStats {
+	median := 52200.0
+	p90 := 259200.0
+	share := 0.25
+
+	return []models.TeamWeekStats{
+		{
+			TeamName:                 "backend",
+			WeekStart:                openapi_types.Date{Time: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)},
+			Opened:                   4,
+			Merged:                   3,
+			MedianOpenToMergeSeconds: &median,
+			P90OpenToMergeSeconds:    &p90,
+			UnderReviewedShare:       &share,
+		},
+		{
+			TeamName:  "backend",
+			WeekStart: openapi_types.Date{Time: time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC)},
+			Opened:    0,
+			Merged:    1,
+		},
+	}
+}
+
+func TestGetStatsTeams_JSON(t *testing.T) {
+	e := echo.New()
+
+	request := httptest.NewRequest(http.MethodGet, "/stats/teams", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	statsServiceMock := new(mocks.MockStatsService)
+
+	statsServiceMock.
+		On("GetTeamStats", mock.Anything, mock.AnythingOfType("*api.GetStatsTeamsParams")).
+		Return(teamWeekStatsFixture(), nil)
+
+	serverMock := newTestServerStats(statsServiceMock)
+
+	err := serverMock.GetStatsTeams(ctx, api.GetStatsTeamsParams{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+	assert.Contains(t, recorder.Body.String(), `"week_start":"2025-11-03"`)
+	assert.Contains(t, recorder.Body.String(), `"under_reviewed_share":0.25`)
+	assert.Contains(t, recorder.Body.String(), `"p90_open_to_merge_seconds":null`)
+	statsServiceMock.AssertExpectations(t)
+}
+
+func TestGetStatsTeams_CSV(t *testing.T) {
+	csvFormat := api.Csv
+
+	tests := []struct {
+		name   string
+		accept string
+		format *api.GetStatsTeamsParamsFormat
+	}{
+		{name: "format parameter", format: &csvFormat},
+		{name: "accept header", accept: "text/csv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+
+			request := httptest.NewRequest(http.MethodGet, "/stats/teams", nil)
+			if tt.accept != "" {
+				request.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+
+			statsServiceMock := new(mocks.MockStatsService)
+
+			statsServiceMock.
+				On("GetTeamStats", mock.Anything, mock.AnythingOfType("*api.GetStatsTeamsParams")).
+				Return(teamWeekStatsFixture(), nil)
+
+			serverMock := newTestServerStats(statsServiceMock)
+
+			err := serverMock.GetStatsTeams(ctx, api.GetStatsTeamsParams{Format: tt.format})
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Header().Get(echo.HeaderContentType), "text/csv")
+			assert.Equal(t,
+				"team_name,week_start,opened,merged,median_open_to_merge_seconds,p90_open_to_merge_seconds,under_reviewed_share\n"+
+					"backend,2025-11-03,4,3,52200,259200,0.25\n"+
+					"backend,2025-11-10,0,1,,,\n",
+				recorder.Body.String(),
+			)
+			statsServiceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetStatsTeams_CSVEscapesFormulas(t *testing.T) {
+	csvFormat := api.Csv
+	week := openapi_types.Date{Time: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)}
+
+	e := echo.New()
+	request := httptest.NewRequest(http.MethodGet, "/stats/teams", nil)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	statsServiceMock := new(mocks.MockStatsService)
+
+	statsServiceMock.
+		On("GetTeamStats", mock.Anything, mock.AnythingOfType("*api.GetStatsTeamsParams")).
+		Return([]models.TeamWeekStats{
+			{TeamName: `=HYPERLINK("http://evil")`, WeekStart: week},
+			{TeamName: "+1", WeekStart: week},
+			{TeamName: "-team", WeekStart: week},
+			{TeamName: "@SUM(A1)", WeekStart: week},
+			{TeamName: "team=a", WeekStart: week},
+		}, nil)
+
+	serverMock := newTestServerStats(statsServiceMock)
+
+	err := serverMock.GetStatsTeams(ctx, api.GetStatsTeamsParams{Format: &csvFormat})
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"team_name,week_start,opened,merged,median_open_to_merge_seconds,p90_open_to_merge_seconds,under_reviewed_share\n"+
+			`"'=HYPERLINK(""http://evil"")",2025-11-03,0,0,,,`+"\n"+
+			"'+1,2025-11-03,0,0,,,\n"+
+			"'-team,2025-11-03,0,0,,,\n"+
+			"'@SUM(A1),2025-11-03,0,0,,,\n"+
+			"team=a,2025-11-03,0,0,,,\n",
+		recorder.Body.String(),
+	)
+	statsServiceMock.AssertExpectations(t)
+}
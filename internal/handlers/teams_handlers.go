@@ -31,6 +31,17 @@ func (s *Server) PostTeamAdd(ctx echo.Context) error {
 				},
 			})
 		}
+		if errors.Is(err, service.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTFOUND,
+					Message: err.Error(),
+				},
+			})
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -56,3 +67,172 @@ func (s *Server) GetTeamGet(ctx echo.Context, params api.GetTeamGetParams) error
 
 	return ctx.JSON(http.StatusOK, team)
 }
+
+func (s *Server) PostTeamUpdate(ctx echo.Context) error {
+	var body api.PostTeamUpdateJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	team, err := s.TeamService.UpdateTeam(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (s *Server) PostTeamAddMembers(ctx echo.Context) error {
+	var body api.PostTeamAddMembersJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	team, err := s.TeamService.AddTeamMembers(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (s *Server) PostTeamRemoveMember(ctx echo.Context) error {
+	var body api.PostTeamRemoveMemberJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	team, err := s.TeamService.RemoveTeamMember(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (s *Server) PostTeamDelete(ctx echo.Context) error {
+	var body api.PostTeamDeleteJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	closed, reassignments, err := s.TeamService.DeleteTeam(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name":            body.TeamName,
+		"closed_pull_requests": closed,
+		"reassignments":        reassignments,
+	})
+}
+
+func (s *Server) GetTeamGetOwnershipRules(ctx echo.Context, params api.GetTeamGetOwnershipRulesParams) error {
+	rules, err := s.TeamService.GetOwnershipRules(ctx.Request().Context(), &params)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name": params.TeamName,
+		"rules":     rules,
+	})
+}
+
+func (s *Server) PostTeamSetOwnershipRules(ctx echo.Context) error {
+	var body api.PostTeamSetOwnershipRulesJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rules, err := s.TeamService.SetOwnershipRules(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name": body.TeamName,
+		"rules":     rules,
+	})
+}
+
+func (s *Server) PostTeamImportCodeowners(ctx echo.Context) error {
+	var body api.PostTeamImportCodeownersJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rules, unknownUsers, err := s.TeamService.ImportCodeowners(ctx.Request().Context(), &body)
+	if err != nil {
+		return s.teamError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name":     body.TeamName,
+		"rules":         rules,
+		"unknown_users": unknownUsers,
+	})
+}
+
+func (s *Server) teamError(ctx echo.Context, err error) error {
+	if errors.Is(err, service.ErrTeamNotFound) || errors.Is(err, service.ErrUserNotFound) {
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: "resource not found",
+			},
+		})
+	}
+
+	if errors.Is(err, service.ErrInvalidOwnershipRule) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if errors.Is(err, service.ErrTeamExists) {
+		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.TEAMEXISTS,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	if errors.Is(err, service.ErrUserExists) {
+		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.USEREXISTS,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	if errors.Is(err, service.ErrHasOpenPullRequests) {
+		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.HASOPENPRS,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"fmt"
 	"github.com/oooooorg/PR-Service/internal/service"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	api "github.com/oooooorg/PR-Service/internal/gen"
 	"github.com/oooooorg/PR-Service/internal/handlers"
 	"github.com/oooooorg/PR-Service/internal/handlers/mocks"
 	"github.com/oooooorg/PR-Service/internal/models"
@@ -97,3 +99,338 @@ func TestPostTeamAdd_AlreadyExists(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, recorder.Code)
 	teamSerivceMock.AssertExpectations(t)
 }
+
+func TestPostTeamAdd_FallbackTeamNotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "members": [
+            { "user_id": "u1", "username": "Alice", "is_active": true }
+        ],
+        "fallback_teams": ["platform"]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"CreateTeam",
+			mock.Anything,
+			mock.AnythingOfType("*api.Team"),
+		).
+		Return(
+			(*models.Team)(nil),
+			service.ErrTeamNotFound,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamAdd(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamUpdate_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{"team_name": "backend", "new_team_name": "core-backend"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/update", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"UpdateTeam",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamUpdateJSONRequestBody"),
+		).
+		Return(
+			&models.Team{
+				TeamName: "core-backend",
+				Members:  []models.TeamMember{},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamUpdate(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "core-backend")
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamAddMembers_UserExists(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "members": [
+            { "user_id": "u1", "username": "Alice", "is_active": true }
+        ]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/addMembers", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"AddTeamMembers",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamAddMembersJSONRequestBody"),
+		).
+		Return(
+			(*models.Team)(nil),
+			service.ErrUserExists,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamAddMembers(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.USEREXISTS))
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamRemoveMember_HasOpenPullRequests(t *testing.T) {
+	e := echo.New()
+
+	body := `{"team_name": "backend", "user_id": "u2"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/removeMember", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"RemoveTeamMember",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamRemoveMemberJSONRequestBody"),
+		).
+		Return(
+			(*models.Team)(nil),
+			service.ErrHasOpenPullRequests,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamRemoveMember(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.HASOPENPRS))
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamDelete_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{"team_name": "backend", "open_prs": "CLOSE"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/delete", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+	newReviewerID := "u7"
+
+	teamSerivceMock.
+		On(
+			"DeleteTeam",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamDeleteJSONRequestBody"),
+		).
+		Return(
+			[]string{"pr-1001"},
+			[]models.ReviewReassignment{{PullRequestId: "pr-2002", OldReviewerId: "u2", NewReviewerId: &newReviewerID, Outcome: api.Reassigned}},
+			nil,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamDelete(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "pr-1001")
+	assert.Contains(t, recorder.Body.String(), `"new_reviewer_id":"u7"`)
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamSetOwnershipRules_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "rules": [
+            {"pattern": "*.go", "owners": ["u1"]},
+            {"pattern": "/internal/repository/", "owners": ["u2", "u3"]}
+        ]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/setOwnershipRules", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"SetOwnershipRules",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamSetOwnershipRulesJSONRequestBody"),
+		).
+		Return(
+			[]models.OwnershipRule{
+				{Pattern: "*.go", Owners: []string{"u1"}},
+				{Pattern: "/internal/repository/", Owners: []string{"u2", "u3"}},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamSetOwnershipRules(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"pattern":"/internal/repository/"`)
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamSetOwnershipRules_OwnerNotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "rules": [{"pattern": "*.go", "owners": ["unknown"]}]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/setOwnershipRules", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"SetOwnershipRules",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamSetOwnershipRulesJSONRequestBody"),
+		).
+		Return(
+			([]models.OwnershipRule)(nil),
+			fmt.Errorf("%w: unknown", service.ErrUserNotFound),
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamSetOwnershipRules(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamImportCodeowners_ReportsUnknownUsers(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "content": "# Go code\n*.go @u1 @ghost\n/docs/ @u2\n"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/importCodeowners", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"ImportCodeowners",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamImportCodeownersJSONRequestBody"),
+		).
+		Return(
+			[]models.OwnershipRule{
+				{Pattern: "*.go", Owners: []string{"u1"}},
+				{Pattern: "/docs/", Owners: []string{"u2"}},
+			},
+			[]string{"ghost"},
+			nil,
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamImportCodeowners(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"unknown_users":["ghost"]`)
+	teamSerivceMock.AssertExpectations(t)
+}
+
+func TestPostTeamImportCodeowners_InvalidPattern(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "content": "src/[ @u1\n"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/team/importCodeowners", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	teamSerivceMock := new(mocks.MockTeamService)
+
+	teamSerivceMock.
+		On(
+			"ImportCodeowners",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostTeamImportCodeownersJSONRequestBody"),
+		).
+		Return(
+			([]models.OwnershipRule)(nil),
+			([]string)(nil),
+			fmt.Errorf("line 1: %w: bad pattern src/[", service.ErrInvalidOwnershipRule),
+		)
+
+	serverMock := newTestServerTeam(teamSerivceMock)
+
+	err := serverMock.PostTeamImportCodeowners(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	teamSerivceMock.AssertExpectations(t)
+}
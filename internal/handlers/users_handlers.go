@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/service"
 )
 
 func (s *Server) PostUsersSetIsActive(ctx echo.Context) error {
@@ -16,7 +17,7 @@ func (s *Server) PostUsersSetIsActive(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	user, err := s.UserService.SetUserActive(ctx.Request().Context(), &body)
+	user, reassignments, err := s.UserService.SetUserActive(ctx.Request().Context(), &body)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
@@ -33,5 +34,171 @@ func (s *Server) PostUsersSetIsActive(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return ctx.JSON(http.StatusOK, user)
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user":          user,
+		"reassignments": reassignments,
+	})
+}
+
+func (s *Server) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var body api.PostUsersSetMaxOpenReviewsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	user, err := s.UserService.SetMaxOpenReviews(ctx.Request().Context(), &body)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTFOUND,
+					Message: "resource not found",
+				},
+			})
+		}
+
+		if errors.Is(err, service.ErrInvalidMaxOpenReviews) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user": user,
+	})
+}
+
+func (s *Server) PostUsersMoveTeam(ctx echo.Context) error {
+	var body api.PostUsersMoveTeamJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	user, reassignments, err := s.UserService.MoveUserToTeam(ctx.Request().Context(), &body)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTFOUND,
+					Message: "resource not found",
+				},
+			})
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user":          user,
+		"reassignments": reassignments,
+	})
+}
+
+func (s *Server) PostUsersBulkDeactivate(ctx echo.Context) error {
+	var body api.PostUsersBulkDeactivateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	users, reassignments, err := s.UserService.BulkDeactivate(ctx.Request().Context(), &body)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+				Error: struct {
+					Code    api.ErrorResponseErrorCode `json:"code"`
+					Message string                     `json:"message"`
+				}{
+					Code:    api.NOTFOUND,
+					Message: "resource not found",
+				},
+			})
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"dry_run":       body.DryRun != nil && *body.DryRun,
+		"users":         users,
+		"reassignments": reassignments,
+	})
+}
+
+func (s *Server) PostUsersAddAbsence(ctx echo.Context) error {
+	var body api.PostUsersAddAbsenceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	absence, err := s.UserService.AddAbsence(ctx.Request().Context(), &body)
+	if err != nil {
+		return absenceError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, absence)
+}
+
+func (s *Server) GetUsersGetAbsences(ctx echo.Context, params api.GetUsersGetAbsencesParams) error {
+	absences, err := s.UserService.GetAbsences(ctx.Request().Context(), &params)
+	if err != nil {
+		return absenceError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user_id":  params.UserId,
+		"absences": absences,
+	})
+}
+
+func (s *Server) PostUsersUpdateAbsence(ctx echo.Context) error {
+	var body api.PostUsersUpdateAbsenceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	absence, err := s.UserService.UpdateAbsence(ctx.Request().Context(), &body)
+	if err != nil {
+		return absenceError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, absence)
+}
+
+func (s *Server) PostUsersDeleteAbsence(ctx echo.Context) error {
+	var body api.PostUsersDeleteAbsenceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.UserService.DeleteAbsence(ctx.Request().Context(), &body); err != nil {
+		return absenceError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func absenceError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound), errors.Is(err, service.ErrAbsenceNotFound):
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: "resource not found",
+			},
+		})
+	case errors.Is(err, service.ErrInvalidAbsenceWindow):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 }
@@ -6,14 +6,17 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	api "github.com/oooooorg/PR-Service/internal/gen"
 	"github.com/oooooorg/PR-Service/internal/handlers"
 	"github.com/oooooorg/PR-Service/internal/handlers/mocks"
 	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/service"
 )
 
 func newTestServerUser(userServiceMock *mocks.MockUserService) *handlers.Server {
@@ -50,6 +53,7 @@ func TestPostUsersSetIsActive_Success(t *testing.T) {
 				TeamName: "backend",
 				IsActive: false,
 			},
+			[]models.ReviewReassignment{},
 			nil,
 		)
 
@@ -85,6 +89,7 @@ func TestPostUsersSetIsActive_NotFound(t *testing.T) {
 		).
 		Return(
 			(*models.User)(nil),
+			([]models.ReviewReassignment)(nil),
 			sql.ErrNoRows,
 		)
 
@@ -96,3 +101,372 @@ func TestPostUsersSetIsActive_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, recorder.Code)
 	userServiceMock.AssertExpectations(t)
 }
+
+func TestPostUsersSetIsActive_ReassignsReviews(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "is_active": false,
+        "reassign_reviews": true
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/setIsActive", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	newReviewer := "u3"
+
+	userServiceMock.
+		On(
+			"SetUserActive",
+			mock.Anything,
+			mock.MatchedBy(func(req *api.PostUsersSetIsActiveJSONRequestBody) bool {
+				return req.ReassignReviews != nil && *req.ReassignReviews
+			}),
+		).
+		Return(
+			&models.User{
+				UserId:   "u2",
+				Username: "Bob",
+				TeamName: "backend",
+				IsActive: false,
+			},
+			[]models.ReviewReassignment{
+				{PullRequestId: "pr-1001", OldReviewerId: "u2", NewReviewerId: &newReviewer},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersSetIsActive(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"reassignments"`)
+	assert.Contains(t, recorder.Body.String(), `"new_reviewer_id":"u3"`)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersMoveTeam_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "team_name": "payments"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/moveTeam", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	newReviewer := "u5"
+
+	userServiceMock.
+		On(
+			"MoveUserToTeam",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersMoveTeamJSONRequestBody"),
+		).
+		Return(
+			&models.User{
+				UserId:   "u2",
+				Username: "Bob",
+				TeamName: "payments",
+				IsActive: true,
+			},
+			[]models.ReviewReassignment{
+				{PullRequestId: "pr-1001", OldReviewerId: "u2", NewReviewerId: &newReviewer},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersMoveTeam(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"new_reviewer_id":"u5"`)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersMoveTeam_TeamNotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "team_name": "unknown"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/moveTeam", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	userServiceMock.
+		On(
+			"MoveUserToTeam",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersMoveTeamJSONRequestBody"),
+		).
+		Return(
+			(*models.User)(nil),
+			([]models.ReviewReassignment)(nil),
+			service.ErrTeamNotFound,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersMoveTeam(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersBulkDeactivate_DryRun(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "team_name": "backend",
+        "dry_run": true
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/bulkDeactivate", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	userServiceMock.
+		On(
+			"BulkDeactivate",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersBulkDeactivateJSONRequestBody"),
+		).
+		Return(
+			[]*models.User{
+				{UserId: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+			},
+			[]models.ReviewReassignment{
+				{PullRequestId: "pr-1001", OldReviewerId: "u2"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersBulkDeactivate(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"dry_run":true`)
+	assert.Contains(t, recorder.Body.String(), `"new_reviewer_id":null`)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersBulkDeactivate_UserNotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_ids": ["u2", "unknown"]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/bulkDeactivate", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	userServiceMock.
+		On(
+			"BulkDeactivate",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersBulkDeactivateJSONRequestBody"),
+		).
+		Return(
+			([]*models.User)(nil),
+			([]models.ReviewReassignment)(nil),
+			service.ErrUserNotFound,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersBulkDeactivate(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersAddAbsence_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "starts_at": "2025-11-03T00:00:00Z",
+        "ends_at": "2025-11-10T00:00:00Z",
+        "reason": "vacation"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/addAbsence", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	reason := "vacation"
+
+	userServiceMock.
+		On(
+			"AddAbsence",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersAddAbsenceJSONRequestBody"),
+		).
+		Return(
+			&models.Absence{
+				AbsenceId: 1,
+				UserId:    "u2",
+				StartsAt:  time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+				EndsAt:    time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC),
+				Reason:    &reason,
+			},
+			nil,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersAddAbsence(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"absence_id":1`)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersAddAbsence_InvalidWindow(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "starts_at": "2025-11-10T00:00:00Z",
+        "ends_at": "2025-11-03T00:00:00Z"
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/addAbsence", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	userServiceMock.
+		On(
+			"AddAbsence",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersAddAbsenceJSONRequestBody"),
+		).
+		Return(
+			(*models.Absence)(nil),
+			service.ErrInvalidAbsenceWindow,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersAddAbsence(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersDeleteAbsence_NotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "absence_id": 42
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/deleteAbsence", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	userServiceMock.
+		On(
+			"DeleteAbsence",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostUsersDeleteAbsenceJSONRequestBody"),
+		).
+		Return(service.ErrAbsenceNotFound)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersDeleteAbsence(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	userServiceMock.AssertExpectations(t)
+}
+
+func TestPostUsersSetMaxOpenReviews_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "user_id": "u2",
+        "max_open_reviews": 2
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/users/setMaxOpenReviews", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	userServiceMock := new(mocks.MockUserService)
+
+	maxOpenReviews := 2
+
+	userServiceMock.
+		On(
+			"SetMaxOpenReviews",
+			mock.Anything,
+			mock.MatchedBy(func(req *api.PostUsersSetMaxOpenReviewsJSONRequestBody) bool {
+				return req.MaxOpenReviews != nil && *req.MaxOpenReviews == 2
+			}),
+		).
+		Return(
+			&models.User{
+				UserId:         "u2",
+				Username:       "Bob",
+				TeamName:       "backend",
+				IsActive:       true,
+				MaxOpenReviews: &maxOpenReviews,
+			},
+			nil,
+		)
+
+	serverMock := newTestServerUser(userServiceMock)
+
+	err := serverMock.PostUsersSetMaxOpenReviews(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"max_open_reviews":2`)
+	userServiceMock.AssertExpectations(t)
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/service"
+)
+
+func (s *Server) PostWebhooksGithub(ctx echo.Context) error {
+	payload, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.GitHubWebhookService.HandleEvent(
+		ctx.Request().Context(),
+		ctx.Request().Header.Get("X-GitHub-Event"),
+		ctx.Request().Header.Get("X-Hub-Signature-256"),
+		payload,
+	)
+	if err != nil {
+		return s.webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pr": pr,
+	})
+}
+
+func (s *Server) PostWebhooksGitlab(ctx echo.Context) error {
+	payload, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := s.GitLabWebhookService.HandleEvent(
+		ctx.Request().Context(),
+		ctx.Request().Header.Get("X-Gitlab-Event"),
+		ctx.Request().Header.Get("X-Gitlab-Token"),
+		payload,
+	)
+	if err != nil {
+		return s.webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pr": pr,
+	})
+}
+
+func (s *Server) webhookError(ctx echo.Context, err error) error {
+	if errors.Is(err, service.ErrWebhookEventIgnored) {
+		return ctx.JSON(http.StatusAccepted, map[string]interface{}{
+			"ignored": true,
+			"reason":  err.Error(),
+		})
+	}
+
+	if errors.Is(err, service.ErrInvalidWebhookSignature) {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	if errors.Is(err, service.ErrInvalidWebhookPayload) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if errors.Is(err, service.ErrUnknownWebhookUser) || errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	if errors.Is(err, service.ErrPullRequestExists) {
+		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.PREXISTS,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	return s.pullRequestStatusError(ctx, err)
+}
+
+func (s *Server) PostWebhooksAddSubscription(ctx echo.Context) error {
+	var body api.PostWebhooksAddSubscriptionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := s.WebhookSubscriptionService.AddSubscription(ctx.Request().Context(), &body)
+	if err != nil {
+		return subscriptionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, subscription)
+}
+
+func (s *Server) GetWebhooksGetSubscriptions(ctx echo.Context) error {
+	subscriptions, err := s.WebhookSubscriptionService.GetSubscriptions(ctx.Request().Context())
+	if err != nil {
+		return subscriptionError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"subscriptions": subscriptions,
+	})
+}
+
+func (s *Server) PostWebhooksDeleteSubscription(ctx echo.Context) error {
+	var body api.PostWebhooksDeleteSubscriptionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.WebhookSubscriptionService.DeleteSubscription(ctx.Request().Context(), &body); err != nil {
+		return subscriptionError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func subscriptionError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrWebhookSubscriptionNotFound):
+		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
+			Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{
+				Code:    api.NOTFOUND,
+				Message: "resource not found",
+			},
+		})
+	case errors.Is(err, service.ErrInvalidWebhookSubscription):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
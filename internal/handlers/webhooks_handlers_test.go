@@ -0,0 +1,503 @@
+package handlers_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/handlers"
+	"github.com/oooooorg/PR-Service/internal/handlers/mocks"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/service"
+)
+
+const testGitHubSecret = "test-secret"
+
+const testGitLabToken = "test-token"
+
+func newTestServerGitHubWebhook(pullRequestServiceMock *mocks.MockPullRequestService) *handlers.Server {
+	return &handlers.Server{
+		GitHubWebhookService: service.NewGitHubWebhookService(
+			slog.New(slog.NewTextHandler(io.Discard, nil)),
+			pullRequestServiceMock,
+			config.GitHubConfig{
+				WebhookSecret: testGitHubSecret,
+				Users:         map[string]string{"alice-gh": "u1"},
+			},
+		),
+	}
+}
+
+func newGitHubWebhookRequest(event, body, secret string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set("X-GitHub-Event", event)
+	request.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return request
+}
+
+func TestPostWebhooksGithub_Opened(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "action": "opened",
+        "number": 42,
+        "pull_request": {"title": "Add search", "draft": false, "merged": false, "user": {"login": "alice-gh"}},
+        "repository": {"full_name": "acme/api"}
+    }`
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitHubWebhookRequest("pull_request", body, testGitHubSecret), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"CreatePullRequest",
+			mock.Anything,
+			mock.MatchedBy(func(req *api.PostPullRequestCreateJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api#42" && req.AuthorId == "u1" && req.PullRequestName == "Add search"
+			}),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "acme/api#42",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            models.PullRequestStatus("OPEN"),
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerGitHubWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGithub(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"acme/api#42"`)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostWebhooksGithub_ClosedMerged(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "action": "closed",
+        "number": 42,
+        "pull_request": {"title": "Add search", "merged": true, "user": {"login": "alice-gh"}},
+        "repository": {"full_name": "acme/api"}
+    }`
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitHubWebhookRequest("pull_request", body, testGitHubSecret), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	pullRequestServiceMock.
+		On(
+			"RecordExternalMerge",
+			mock.Anything,
+			mock.MatchedBy(func(req *api.PostPullRequestMergeJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api#42"
+			}),
+		).
+		Return(
+			&models.PullRequest{
+				PullRequestId:     "acme/api#42",
+				PullRequestName:   "Add search",
+				AuthorId:          "u1",
+				Status:            models.PullRequestStatus("MERGED"),
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			nil,
+		)
+
+	serverMock := newTestServerGitHubWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGithub(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"MERGED"`)
+	pullRequestServiceMock.AssertExpectations(t)
+}
+
+func TestPostWebhooksGithub_InvalidSignature(t *testing.T) {
+	e := echo.New()
+
+	body := `{"action": "opened", "number": 42, "repository": {"full_name": "acme/api"}}`
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitHubWebhookRequest("pull_request", body, "wrong-secret"), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	serverMock := newTestServerGitHubWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGithub(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnauthorized, httpErr.Code)
+	pullRequestServiceMock.AssertNotCalled(t, "CreatePullRequest", mock.Anything, mock.Anything)
+}
+
+func TestPostWebhooksGithub_UnknownLogin(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "action": "opened",
+        "number": 43,
+        "pull_request": {"title": "Fix typo", "user": {"login": "stranger"}},
+        "repository": {"full_name": "acme/api"}
+    }`
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitHubWebhookRequest("pull_request", body, testGitHubSecret), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	serverMock := newTestServerGitHubWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGithub(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "stranger")
+}
+
+func TestPostWebhooksGithub_IgnoredAction(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "action": "labeled",
+        "number": 42,
+        "pull_request": {"title": "Add search", "user": {"login": "alice-gh"}},
+        "repository": {"full_name": "acme/api"}
+    }`
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitHubWebhookRequest("pull_request", body, testGitHubSecret), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	serverMock := newTestServerGitHubWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGithub(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"ignored":true`)
+}
+
+func newTestServerGitLabWebhook(pullRequestServiceMock *mocks.MockPullRequestService) *handlers.Server {
+	return &handlers.Server{
+		GitLabWebhookService: service.NewGitLabWebhookService(
+			slog.New(slog.NewTextHandler(io.Discard, nil)),
+			pullRequestServiceMock,
+			config.GitLabConfig{
+				WebhookToken: testGitLabToken,
+				Users:        map[string]string{"alice": "u1"},
+			},
+		),
+	}
+}
+
+func newGitLabWebhookRequest(t *testing.T, event, fixture, token string) *http.Request {
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab", fixture))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", fixture, err)
+	}
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", strings.NewReader(string(body)))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set("X-Gitlab-Event", event)
+	request.Header.Set("X-Gitlab-Token", token)
+	return request
+}
+
+func TestPostWebhooksGitlab_MergeRequestActions(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		method  string
+		request interface{}
+		status  string
+	}{
+		{
+			name:    "open",
+			fixture: "merge_request_open.json",
+			method:  "CreatePullRequest",
+			request: mock.MatchedBy(func(req *api.PostPullRequestCreateJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7" && req.AuthorId == "u1" && req.PullRequestName == "Add search" && !*req.Draft
+			}),
+			status: "OPEN",
+		},
+		{
+			name:    "open draft",
+			fixture: "merge_request_open_draft.json",
+			method:  "CreatePullRequest",
+			request: mock.MatchedBy(func(req *api.PostPullRequestCreateJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7" && req.AuthorId == "u1" && *req.Draft
+			}),
+			status: "DRAFT",
+		},
+		{
+			name:    "merge",
+			fixture: "merge_request_merge.json",
+			method:  "RecordExternalMerge",
+			request: mock.MatchedBy(func(req *api.PostPullRequestMergeJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7"
+			}),
+			status: "MERGED",
+		},
+		{
+			name:    "close",
+			fixture: "merge_request_close.json",
+			method:  "ClosePullRequest",
+			request: mock.MatchedBy(func(req *api.PostPullRequestCloseJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7"
+			}),
+			status: "CLOSED",
+		},
+		{
+			name:    "reopen",
+			fixture: "merge_request_reopen.json",
+			method:  "ReopenPullRequest",
+			request: mock.MatchedBy(func(req *api.PostPullRequestReopenJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7"
+			}),
+			status: "OPEN",
+		},
+		{
+			name:    "mark draft",
+			fixture: "merge_request_mark_draft.json",
+			method:  "MarkPullRequestDraft",
+			request: mock.MatchedBy(func(req *api.PostPullRequestDraftJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7"
+			}),
+			status: "DRAFT",
+		},
+		{
+			name:    "mark ready",
+			fixture: "merge_request_mark_ready.json",
+			method:  "MarkPullRequestReady",
+			request: mock.MatchedBy(func(req *api.PostPullRequestReadyJSONRequestBody) bool {
+				return req.PullRequestId == "acme/api!7"
+			}),
+			status: "OPEN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(newGitLabWebhookRequest(t, "Merge Request Hook", tt.fixture, testGitLabToken), recorder)
+
+			pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+			pullRequestServiceMock.
+				On(tt.method, mock.Anything, tt.request).
+				Return(
+					&models.PullRequest{
+						PullRequestId:   "acme/api!7",
+						PullRequestName: "Add search",
+						AuthorId:        "u1",
+						Status:          models.PullRequestStatus(tt.status),
+					},
+					nil,
+				)
+
+			serverMock := newTestServerGitLabWebhook(pullRequestServiceMock)
+
+			err := serverMock.PostWebhooksGitlab(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), `"`+tt.status+`"`)
+			pullRequestServiceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostWebhooksGitlab_InvalidToken(t *testing.T) {
+	e := echo.New()
+
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(newGitLabWebhookRequest(t, "Merge Request Hook", "merge_request_open.json", "wrong-token"), recorder)
+
+	pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+	serverMock := newTestServerGitLabWebhook(pullRequestServiceMock)
+
+	err := serverMock.PostWebhooksGitlab(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnauthorized, httpErr.Code)
+	pullRequestServiceMock.AssertNotCalled(t, "CreatePullRequest", mock.Anything, mock.Anything)
+}
+
+func TestPostWebhooksGitlab_IgnoredEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		fixture string
+	}{
+		{name: "approved", event: "Merge Request Hook", fixture: "merge_request_approved.json"},
+		{name: "title update", event: "Merge Request Hook", fixture: "merge_request_update_title.json"},
+		{name: "other event", event: "Push Hook", fixture: "merge_request_open.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(newGitLabWebhookRequest(t, tt.event, tt.fixture, testGitLabToken), recorder)
+
+			pullRequestServiceMock := new(mocks.MockPullRequestService)
+
+			serverMock := newTestServerGitLabWebhook(pullRequestServiceMock)
+
+			err := serverMock.PostWebhooksGitlab(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusAccepted, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), `"ignored":true`)
+			pullRequestServiceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func newTestServerWebhookSubscription(webhookSubscriptionServiceMock *mocks.MockWebhookSubscriptionService) *handlers.Server {
+	return &handlers.Server{
+		WebhookSubscriptionService: webhookSubscriptionServiceMock,
+	}
+}
+
+func TestPostWebhooksAddSubscription_Success(t *testing.T) {
+	e := echo.New()
+
+	body := `{
+        "url": "https://hooks.example.com/pr-service",
+        "secret": "s3cr3t",
+        "events": ["reviewer.assigned", "reviewer.unassigned"]
+    }`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/addSubscription", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	webhookSubscriptionServiceMock := new(mocks.MockWebhookSubscriptionService)
+
+	webhookSubscriptionServiceMock.
+		On(
+			"AddSubscription",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostWebhooksAddSubscriptionJSONRequestBody"),
+		).
+		Return(
+			&models.WebhookSubscription{
+				SubscriptionId: 1,
+				Url:            "https://hooks.example.com/pr-service",
+				Events:         []models.WebhookEventType{api.ReviewerAssigned, api.ReviewerUnassigned},
+				CreatedAt:      time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+			},
+			nil,
+		)
+
+	serverMock := newTestServerWebhookSubscription(webhookSubscriptionServiceMock)
+
+	err := serverMock.PostWebhooksAddSubscription(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"subscription_id":1`)
+	assert.NotContains(t, recorder.Body.String(), "s3cr3t")
+	webhookSubscriptionServiceMock.AssertExpectations(t)
+}
+
+func TestPostWebhooksAddSubscription_UnknownEvent(t *testing.T) {
+	e := echo.New()
+
+	body := `{"url": "https://hooks.example.com/pr-service", "secret": "s3cr3t", "events": ["pr.opened"]}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/addSubscription", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	webhookSubscriptionServiceMock := new(mocks.MockWebhookSubscriptionService)
+
+	webhookSubscriptionServiceMock.
+		On(
+			"AddSubscription",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostWebhooksAddSubscriptionJSONRequestBody"),
+		).
+		Return(nil, fmt.Errorf("%w: unknown event pr.opened", service.ErrInvalidWebhookSubscription))
+
+	serverMock := newTestServerWebhookSubscription(webhookSubscriptionServiceMock)
+
+	err := serverMock.PostWebhooksAddSubscription(ctx)
+
+	var httpErr *echo.HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	webhookSubscriptionServiceMock.AssertExpectations(t)
+}
+
+func TestPostWebhooksDeleteSubscription_NotFound(t *testing.T) {
+	e := echo.New()
+
+	body := `{"subscription_id": 42}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/deleteSubscription", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ctx := e.NewContext(request, recorder)
+
+	webhookSubscriptionServiceMock := new(mocks.MockWebhookSubscriptionService)
+
+	webhookSubscriptionServiceMock.
+		On(
+			"DeleteSubscription",
+			mock.Anything,
+			mock.AnythingOfType("*api.PostWebhooksDeleteSubscriptionJSONRequestBody"),
+		).
+		Return(service.ErrWebhookSubscriptionNotFound)
+
+	serverMock := newTestServerWebhookSubscription(webhookSubscriptionServiceMock)
+
+	err := serverMock.PostWebhooksDeleteSubscription(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), string(api.NOTFOUND))
+	webhookSubscriptionServiceMock.AssertExpectations(t)
+}
@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/oooooorg/PR-Service/internal/service"
+)
+
+// ActorHeader names who made the request for the pull request history. The
+// value is asserted by the client and not authenticated, so the history
+// records it as reported, not as a verified identity.
+const ActorHeader = "X-Actor-Id"
+
+func ActorMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if actorID := c.Request().Header.Get(ActorHeader); actorID != "" {
+				c.SetRequest(c.Request().WithContext(service.WithActor(c.Request().Context(), actorID)))
+			}
+			return next(c)
+		}
+	}
+}
@@ -3,12 +3,22 @@ package models
 import api "github.com/oooooorg/PR-Service/internal/gen"
 
 type (
-	PullRequest       = api.PullRequest
-	PullRequestShort  = api.PullRequestShort
-	Team              = api.Team
-	TeamGetParams     = api.GetTeamGetParams
-	TeamMember        = api.TeamMember
-	User              = api.User
-	ErrorResponse     = api.ErrorResponse
-	PullRequestStatus = api.PullRequestStatus
+	Absence             = api.Absence
+	OwnershipRule       = api.OwnershipRule
+	PullRequest         = api.PullRequest
+	PullRequestHistory  = api.PullRequestHistoryEntry
+	PullRequestSnapshot = api.PullRequestSnapshot
+	PullRequestShort    = api.PullRequestShort
+	PullRequestReview   = api.PullRequestReview
+	ReviewReassignment  = api.ReviewReassignment
+	ReviewerStats       = api.ReviewerStats
+	Team                = api.Team
+	TeamGetParams       = api.GetTeamGetParams
+	TeamMember          = api.TeamMember
+	TeamWeekStats       = api.TeamWeekStats
+	User                = api.User
+	ErrorResponse       = api.ErrorResponse
+	PullRequestStatus   = api.PullRequestStatus
+	WebhookEventType    = api.WebhookEventType
+	WebhookSubscription = api.WebhookSubscription
 )
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type AbsenceRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewAbsenceRepository(db *sql.DB) *AbsenceRepositoryImpl {
+	return &AbsenceRepositoryImpl{
+		db: db,
+	}
+}
+
+func (ar *AbsenceRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return ar.db.BeginTx(ctx, nil)
+}
+
+func (ar *AbsenceRepositoryImpl) CreateAbsence(ctx context.Context, tx *sql.Tx, absence *entity.Absence) error {
+	const query = `
+        INSERT INTO user_absences (user_id, starts_at, ends_at, reason, created_at)
+        VALUES ($1, $2, $3, $4, NOW())
+        RETURNING id, created_at`
+
+	args := []any{absence.UserID, absence.StartsAt, absence.EndsAt, absence.Reason}
+
+	if tx != nil {
+		return tx.QueryRowContext(ctx, query, args...).Scan(&absence.ID, &absence.CreatedAt)
+	}
+	return ar.db.QueryRowContext(ctx, query, args...).Scan(&absence.ID, &absence.CreatedAt)
+}
+
+func (ar *AbsenceRepositoryImpl) GetAbsenceByID(ctx context.Context, tx *sql.Tx, absenceID int) (*entity.Absence, error) {
+	const query = `
+        SELECT id, user_id, starts_at, ends_at, reason, handed_over_at, created_at
+        FROM user_absences
+        WHERE id = $1
+    `
+
+	var absence entity.Absence
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, absenceID).Scan(
+			&absence.ID, &absence.UserID, &absence.StartsAt, &absence.EndsAt, &absence.Reason, &absence.HandedOverAt, &absence.CreatedAt,
+		)
+	} else {
+		err = ar.db.QueryRowContext(ctx, query, absenceID).Scan(
+			&absence.ID, &absence.UserID, &absence.StartsAt, &absence.EndsAt, &absence.Reason, &absence.HandedOverAt, &absence.CreatedAt,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &absence, nil
+}
+
+func (ar *AbsenceRepositoryImpl) GetAbsencesByUser(ctx context.Context, tx *sql.Tx, userID string) ([]*entity.Absence, error) {
+	const query = `
+        SELECT id, user_id, starts_at, ends_at, reason, handed_over_at, created_at
+        FROM user_absences
+        WHERE user_id = $1
+        ORDER BY starts_at
+    `
+
+	return ar.queryAbsences(ctx, tx, query, userID)
+}
+
+func (ar *AbsenceRepositoryImpl) GetStartedAbsences(ctx context.Context, tx *sql.Tx) ([]*entity.Absence, error) {
+	const query = `
+        SELECT id, user_id, starts_at, ends_at, reason, handed_over_at, created_at
+        FROM user_absences
+        WHERE handed_over_at IS NULL AND starts_at <= NOW() AND ends_at > NOW()
+        ORDER BY starts_at
+        FOR UPDATE SKIP LOCKED
+    `
+
+	return ar.queryAbsences(ctx, tx, query)
+}
+
+func (ar *AbsenceRepositoryImpl) GetAbsentUserIDs(ctx context.Context, tx *sql.Tx, userIDs []string) ([]string, error) {
+	const query = `
+        SELECT DISTINCT user_id
+        FROM user_absences
+        WHERE user_id = ANY($1) AND starts_at <= NOW() AND ends_at > NOW()
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, pq.Array(userIDs))
+	} else {
+		rows, err = ar.db.QueryContext(ctx, query, pq.Array(userIDs))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	absent := []string{}
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		absent = append(absent, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return absent, nil
+}
+
+func (ar *AbsenceRepositoryImpl) UpdateAbsence(ctx context.Context, tx *sql.Tx, absence *entity.Absence) error {
+	const query = `
+        UPDATE user_absences
+        SET starts_at = $1, ends_at = $2, reason = $3, handed_over_at = $4
+        WHERE id = $5`
+
+	args := []any{absence.StartsAt, absence.EndsAt, absence.Reason, absence.HandedOverAt, absence.ID}
+
+	return ar.execAffectingOne(ctx, tx, query, args...)
+}
+
+func (ar *AbsenceRepositoryImpl) MarkAbsenceHandedOver(ctx context.Context, tx *sql.Tx, absenceID int) error {
+	const query = `UPDATE user_absences SET handed_over_at = NOW() WHERE id = $1`
+
+	return ar.execAffectingOne(ctx, tx, query, absenceID)
+}
+
+func (ar *AbsenceRepositoryImpl) DeleteAbsence(ctx context.Context, tx *sql.Tx, absenceID int) error {
+	const query = `DELETE FROM user_absences WHERE id = $1`
+
+	return ar.execAffectingOne(ctx, tx, query, absenceID)
+}
+
+func (ar *AbsenceRepositoryImpl) queryAbsences(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*entity.Absence, error) {
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = ar.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	absences := []*entity.Absence{}
+	for rows.Next() {
+		var absence entity.Absence
+		if err := rows.Scan(
+			&absence.ID, &absence.UserID, &absence.StartsAt, &absence.EndsAt, &absence.Reason, &absence.HandedOverAt, &absence.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		absences = append(absences, &absence)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return absences, nil
+}
+
+func (ar *AbsenceRepositoryImpl) execAffectingOne(ctx context.Context, tx *sql.Tx, query string, args ...any) error {
+	var res sql.Result
+	var err error
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		res, err = ar.db.ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/oooooorg/PR-Service/internal/entity"
 )
@@ -13,13 +14,24 @@ type UserRepository interface {
 	GetUsersByTeam(ctx context.Context, tx *sql.Tx, teamName string) ([]*entity.User, error)
 	GetUserByID(ctx context.Context, tx *sql.Tx, userID string) (*entity.User, error)
 	SetUserActive(ctx context.Context, tx *sql.Tx, userID string, isActive bool) (*entity.User, error)
+	SetUserTeam(ctx context.Context, tx *sql.Tx, userID string, teamName string) (*entity.User, error)
+	SetUserMaxOpenReviews(ctx context.Context, tx *sql.Tx, userID string, maxOpenReviews *int) (*entity.User, error)
+	RemoveUserFromTeam(ctx context.Context, tx *sql.Tx, userID string) (*entity.User, error)
+	DeactivateUsersByTeam(ctx context.Context, tx *sql.Tx, teamName string) error
 }
 
 type TeamRepository interface {
 	BeginTx(ctx context.Context) (*sql.Tx, error)
 	CreateTeam(ctx context.Context, tx *sql.Tx, team *entity.Team) error
 	GetTeamByName(ctx context.Context, tx *sql.Tx, teamName string) (*entity.Team, error)
+	UpdateTeam(ctx context.Context, tx *sql.Tx, teamName string, team *entity.Team) error
+	DeleteTeam(ctx context.Context, tx *sql.Tx, teamName string) error
 	TeamExists(ctx context.Context, tx *sql.Tx, teamName string) (bool, error)
+	GetRoundRobinCursor(ctx context.Context, tx *sql.Tx, teamName string) (string, error)
+	SetRoundRobinCursor(ctx context.Context, tx *sql.Tx, teamName string, userID string) error
+	SetFallbackTeams(ctx context.Context, tx *sql.Tx, teamName string, fallbackTeams []string) error
+	GetOwnershipRules(ctx context.Context, tx *sql.Tx, teamName string) ([]*entity.OwnershipRule, error)
+	SetOwnershipRules(ctx context.Context, tx *sql.Tx, teamName string, rules []*entity.OwnershipRule) error
 }
 
 type PullRequestRepository interface {
@@ -27,6 +39,60 @@ type PullRequestRepository interface {
 	CreatePullRequest(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest) error
 	GetPullRequestByID(ctx context.Context, tx *sql.Tx, prID string) (*entity.PullRequest, error)
 	UpdatePullRequestStatus(ctx context.Context, tx *sql.Tx, prID string, status string) (*entity.PullRequest, error)
-	UpdatePullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string, reviewer1, reviewer2 string) (*entity.PullRequest, error)
+	GetPullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string) ([]string, error)
+	SetPullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string, reviewerIDs []string) error
+	ReplacePullRequestReviewer(ctx context.Context, tx *sql.Tx, prID string, oldReviewerID, newReviewerID string) (*entity.PullRequest, error)
+	RemovePullRequestReviewer(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error
 	GetPullRequestsByReviewer(ctx context.Context, tx *sql.Tx, reviewerID string) ([]*entity.PullRequest, error)
+	GetOpenPullRequestsByUsers(ctx context.Context, tx *sql.Tx, userIDs []string) ([]*entity.PullRequest, error)
+	CountOpenReviews(ctx context.Context, tx *sql.Tx, reviewerIDs []string) (map[string]int, error)
+	GetSilentReviews(ctx context.Context, tx *sql.Tx) ([]*entity.SilentReview, error)
+	MarkReviewReminded(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error
+	MarkReviewEscalated(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error
+}
+
+type ReviewRepository interface {
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	UpsertReview(ctx context.Context, tx *sql.Tx, review *entity.Review) error
+	GetReviewsByPullRequest(ctx context.Context, tx *sql.Tx, prID string) ([]*entity.Review, error)
+}
+
+type AbsenceRepository interface {
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	CreateAbsence(ctx context.Context, tx *sql.Tx, absence *entity.Absence) error
+	GetAbsenceByID(ctx context.Context, tx *sql.Tx, absenceID int) (*entity.Absence, error)
+	GetAbsencesByUser(ctx context.Context, tx *sql.Tx, userID string) ([]*entity.Absence, error)
+	GetStartedAbsences(ctx context.Context, tx *sql.Tx) ([]*entity.Absence, error)
+	GetAbsentUserIDs(ctx context.Context, tx *sql.Tx, userIDs []string) ([]string, error)
+	UpdateAbsence(ctx context.Context, tx *sql.Tx, absence *entity.Absence) error
+	MarkAbsenceHandedOver(ctx context.Context, tx *sql.Tx, absenceID int) error
+	DeleteAbsence(ctx context.Context, tx *sql.Tx, absenceID int) error
+}
+
+type WebhookRepository interface {
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	CreateSubscription(ctx context.Context, tx *sql.Tx, subscription *entity.WebhookSubscription) error
+	GetSubscriptions(ctx context.Context, tx *sql.Tx) ([]*entity.WebhookSubscription, error)
+	GetSubscriptionsByEvent(ctx context.Context, tx *sql.Tx, event string) ([]*entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, tx *sql.Tx, subscriptionID int) error
+}
+
+type OutboxRepository interface {
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	AddEvents(ctx context.Context, tx *sql.Tx, events []entity.Event) error
+	ClaimPendingEvents(ctx context.Context, tx *sql.Tx, limit int, lease time.Duration) ([]*entity.OutboxEntry, error)
+	MarkEventsDelivered(ctx context.Context, tx *sql.Tx, eventIDs []int64) error
+	MarkEventFailed(ctx context.Context, tx *sql.Tx, eventID int64, deliveredTargets []string, reason string, retryAfter time.Duration) error
+	MarkEventDead(ctx context.Context, tx *sql.Tx, eventID int64, deliveredTargets []string, reason string) error
+}
+
+type PullRequestHistoryRepository interface {
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+	AddEntries(ctx context.Context, tx *sql.Tx, entries []entity.PullRequestHistoryEntry) error
+	GetHistory(ctx context.Context, tx *sql.Tx, prID string) ([]*entity.PullRequestHistoryEntry, error)
+}
+
+type StatsRepository interface {
+	GetReviewerStats(ctx context.Context, tx *sql.Tx, filter entity.StatsFilter) ([]*entity.ReviewerStats, error)
+	GetTeamWeeklyStats(ctx context.Context, tx *sql.Tx, filter entity.StatsFilter) ([]*entity.TeamWeekStats, error)
 }
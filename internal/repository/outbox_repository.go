@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type OutboxRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepositoryImpl {
+	return &OutboxRepositoryImpl{
+		db: db,
+	}
+}
+
+func (ob *OutboxRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return ob.db.BeginTx(ctx, nil)
+}
+
+func (ob *OutboxRepositoryImpl) AddEvents(ctx context.Context, tx *sql.Tx, events []entity.Event) error {
+	const query = `
+        INSERT INTO outbox (event_type, payload, created_at)
+        VALUES ($1, $2, NOW())`
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		if tx != nil {
+			_, err = tx.ExecContext(ctx, query, string(event.Type), payload)
+		} else {
+			_, err = ob.db.ExecContext(ctx, query, string(event.Type), payload)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClaimPendingEvents pushes next_attempt_at of the claimed rows forward by
+// lease, so other dispatchers skip them while they are being delivered
+// outside the claiming transaction.
+func (ob *OutboxRepositoryImpl) ClaimPendingEvents(ctx context.Context, tx *sql.Tx, limit int, lease time.Duration) ([]*entity.OutboxEntry, error) {
+	const query = `
+        UPDATE outbox
+        SET next_attempt_at = NOW() + make_interval(secs => $2)
+        WHERE id IN (
+            SELECT id
+            FROM outbox
+            WHERE delivered_at IS NULL AND failed_at IS NULL AND next_attempt_at <= NOW()
+            ORDER BY id
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, payload, attempts, delivered_targets
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, limit, lease.Seconds())
+	} else {
+		rows, err = ob.db.QueryContext(ctx, query, limit, lease.Seconds())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*entity.OutboxEntry{}
+	for rows.Next() {
+		var id int64
+		var payload []byte
+		var entry entity.OutboxEntry
+		if err := rows.Scan(&id, &payload, &entry.Attempts, pq.Array(&entry.DeliveredTargets)); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(payload, &entry.Event); err != nil {
+			return nil, err
+		}
+		entry.Event.ID = id
+
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(entries, func(a, b *entity.OutboxEntry) int {
+		return cmp.Compare(a.Event.ID, b.Event.ID)
+	})
+
+	return entries, nil
+}
+
+func (ob *OutboxRepositoryImpl) MarkEventsDelivered(ctx context.Context, tx *sql.Tx, eventIDs []int64) error {
+	const query = `
+        UPDATE outbox
+        SET delivered_at = NOW(), attempts = attempts + 1, last_error = NULL
+        WHERE id = ANY($1)`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, pq.Array(eventIDs))
+	} else {
+		_, err = ob.db.ExecContext(ctx, query, pq.Array(eventIDs))
+	}
+
+	return err
+}
+
+func (ob *OutboxRepositoryImpl) MarkEventFailed(ctx context.Context, tx *sql.Tx, eventID int64, deliveredTargets []string, reason string, retryAfter time.Duration) error {
+	const query = `
+        UPDATE outbox
+        SET attempts = attempts + 1, last_error = $2, delivered_targets = $3,
+            next_attempt_at = NOW() + make_interval(secs => $4)
+        WHERE id = $1`
+
+	args := []any{eventID, reason, pq.Array(deliveredTargets), retryAfter.Seconds()}
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = ob.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (ob *OutboxRepositoryImpl) MarkEventDead(ctx context.Context, tx *sql.Tx, eventID int64, deliveredTargets []string, reason string) error {
+	const query = `
+        UPDATE outbox
+        SET attempts = attempts + 1, last_error = $2, delivered_targets = $3, failed_at = NOW()
+        WHERE id = $1`
+
+	args := []any{eventID, reason, pq.Array(deliveredTargets)}
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = ob.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type PullRequestHistoryRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPullRequestHistoryRepository(db *sql.DB) *PullRequestHistoryRepositoryImpl {
+	return &PullRequestHistoryRepositoryImpl{
+		db: db,
+	}
+}
+
+func (h *PullRequestHistoryRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return h.db.BeginTx(ctx, nil)
+}
+
+func (h *PullRequestHistoryRepositoryImpl) AddEntries(ctx context.Context, tx *sql.Tx, entries []entity.PullRequestHistoryEntry) error {
+	const query = `
+        INSERT INTO pull_request_events (pull_request_id, action, actor_id, before, after, reason, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW())`
+
+	for _, entry := range entries {
+		before, err := marshalSnapshot(entry.Before)
+		if err != nil {
+			return err
+		}
+
+		after, err := marshalSnapshot(entry.After)
+		if err != nil {
+			return err
+		}
+
+		args := []any{entry.PullRequestID, string(entry.Action), entry.ActorID, before, after, entry.Reason}
+
+		if tx != nil {
+			_, err = tx.ExecContext(ctx, query, args...)
+		} else {
+			_, err = h.db.ExecContext(ctx, query, args...)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *PullRequestHistoryRepositoryImpl) GetHistory(ctx context.Context, tx *sql.Tx, prID string) ([]*entity.PullRequestHistoryEntry, error) {
+	const query = `
+        SELECT id, pull_request_id, action, actor_id, before, after, reason, created_at
+        FROM pull_request_events
+        WHERE pull_request_id = $1
+        ORDER BY id
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, prID)
+	} else {
+		rows, err = h.db.QueryContext(ctx, query, prID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*entity.PullRequestHistoryEntry{}
+	for rows.Next() {
+		var entry entity.PullRequestHistoryEntry
+		var action string
+		var before, after []byte
+
+		if err := rows.Scan(&entry.ID, &entry.PullRequestID, &action, &entry.ActorID, &before, &after, &entry.Reason, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entry.Action = entity.HistoryAction(action)
+
+		if entry.Before, err = unmarshalSnapshot(before); err != nil {
+			return nil, err
+		}
+		if entry.After, err = unmarshalSnapshot(after); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func marshalSnapshot(snapshot *entity.PullRequestSnapshot) (any, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	return json.Marshal(snapshot)
+}
+
+func unmarshalSnapshot(data []byte) (*entity.PullRequestSnapshot, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var snapshot entity.PullRequestSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
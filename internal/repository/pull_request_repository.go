@@ -3,11 +3,22 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 
-	"github.com/oooooorg/PR-Service/internal/database"
 	"github.com/oooooorg/PR-Service/internal/entity"
 )
 
+const pullRequestReviewersColumn = `
+        ARRAY(
+            SELECT r.reviewer_id
+            FROM pull_request_reviewers r
+            WHERE r.pull_request_id = pull_requests.pull_request_id
+            ORDER BY r.position
+        )`
+
 type PullRequestRepositoryImpl struct {
 	db *sql.DB
 }
@@ -25,105 +36,208 @@ func (ps *PullRequestRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, erro
 func (ps *PullRequestRepositoryImpl) CreatePullRequest(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest) error {
 	const query = `
         INSERT INTO pull_requests (
-            author_id, pull_request_id, pull_request_name, 
-            assigned_reviewers_first, assigned_reviewers_second, status,
+            author_id, pull_request_id, pull_request_name, status, changed_files,
             created_at, updated_at_utc
-        ) 
-        VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW()) 
+        )
+        VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
         RETURNING id, created_at, updated_at_utc`
 
 	args := []any{
 		pr.AuthorID,
 		pr.PullRequestID,
 		pr.PullRequestName,
-		database.StringToNullString(pr.AssignedReviewersFirst),
-		database.StringToNullString(pr.AssignedReviewersSecond),
 		pr.Status,
+		pq.Array(pr.ChangedFiles),
 	}
 
+	var err error
+
 	if tx != nil {
-		return tx.QueryRowContext(ctx, query, args...).Scan(&pr.ID, &pr.CreatedAt, &pr.UpdatedAt)
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&pr.ID, &pr.CreatedAt, &pr.UpdatedAt)
+	} else {
+		err = ps.db.QueryRowContext(ctx, query, args...).Scan(&pr.ID, &pr.CreatedAt, &pr.UpdatedAt)
 	}
-	return ps.db.QueryRowContext(ctx, query, args...).Scan(&pr.ID, &pr.CreatedAt, &pr.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return ps.SetPullRequestReviewers(ctx, tx, pr.PullRequestID, pr.AssignedReviewers)
 }
 
-func (ps *PullRequestRepositoryImpl) UpdatePullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string, reviewer1, reviewer2 string) (*entity.PullRequest, error) {
+func (ps *PullRequestRepositoryImpl) GetPullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string) ([]string, error) {
 	const query = `
-		UPDATE pull_requests 
-        SET assigned_reviewers_first = $1, 
-            assigned_reviewers_second = $2, 
-            updated_at_utc = NOW() 
-        WHERE pull_request_id = $3
-        RETURNING id, author_id, pull_request_id, pull_request_name, 
-                  assigned_reviewers_first, assigned_reviewers_second, 
-                  status, created_at, updated_at_utc, merged_at`
-	args := []any{
-		database.StringToNullString(reviewer1),
-		database.StringToNullString(reviewer2),
-		prID,
+        SELECT reviewer_id
+        FROM pull_request_reviewers
+        WHERE pull_request_id = $1
+        ORDER BY position
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, prID)
+	} else {
+		rows, err = ps.db.QueryContext(ctx, query, prID)
 	}
 
-	var pr entity.PullRequest
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviewers := []string{}
+	for rows.Next() {
+		var reviewerID string
+		if err := rows.Scan(&reviewerID); err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, reviewerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
+func (ps *PullRequestRepositoryImpl) SetPullRequestReviewers(ctx context.Context, tx *sql.Tx, prID string, reviewerIDs []string) error {
+	const deleteQuery = `DELETE FROM pull_request_reviewers WHERE pull_request_id = $1`
+	const insertQuery = `
+        INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, position, assigned_at)
+        SELECT $1, reviewer_id, position, NOW()
+        FROM UNNEST($2::VARCHAR[]) WITH ORDINALITY AS reviewers(reviewer_id, position)`
+
 	var err error
-	var rev1, rev2 sql.NullString
 
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(
-			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
-		)
+		_, err = tx.ExecContext(ctx, deleteQuery, prID)
 	} else {
-		err = ps.db.QueryRowContext(ctx, query, args...).Scan(
-			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
-		)
+		_, err = ps.db.ExecContext(ctx, deleteQuery, prID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, insertQuery, prID, pq.Array(reviewerIDs))
+	} else {
+		_, err = ps.db.ExecContext(ctx, insertQuery, prID, pq.Array(reviewerIDs))
+	}
+
+	return err
+}
+
+func (ps *PullRequestRepositoryImpl) ReplacePullRequestReviewer(ctx context.Context, tx *sql.Tx, prID string, oldReviewerID, newReviewerID string) (*entity.PullRequest, error) {
+	const query = `
+        UPDATE pull_request_reviewers
+        SET reviewer_id = $1, assigned_at = NOW()
+        WHERE pull_request_id = $2 AND reviewer_id = $3`
+
+	args := []any{newReviewerID, prID, oldReviewerID}
+
+	var res sql.Result
+	var err error
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		res, err = ps.db.ExecContext(ctx, query, args...)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if rev1.Valid {
-		pr.AssignedReviewersFirst = rev1.String
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
 	}
-	if rev2.Valid {
-		pr.AssignedReviewersSecond = rev2.String
+	if affected == 0 {
+		return nil, sql.ErrNoRows
 	}
 
-	return &pr, nil
+	return ps.GetPullRequestByID(ctx, tx, prID)
+}
+
+func (ps *PullRequestRepositoryImpl) RemovePullRequestReviewer(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error {
+	const query = `DELETE FROM pull_request_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2`
+
+	var res sql.Result
+	var err error
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, prID, reviewerID)
+	} else {
+		res, err = ps.db.ExecContext(ctx, query, prID, reviewerID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
 }
 
 func (ps *PullRequestRepositoryImpl) UpdatePullRequestStatus(ctx context.Context, tx *sql.Tx, prID string, status string) (*entity.PullRequest, error) {
 	var query string
-	if status == "MERGED" {
+	switch entity.PullRequestStatus(status) {
+	case entity.StatusMerged:
 		query = `
-            UPDATE pull_requests 
+            UPDATE pull_requests
             SET status = $1, updated_at_utc = NOW(), merged_at = NOW()
             WHERE pull_request_id = $2
-            RETURNING id, author_id, pull_request_id, pull_request_name, 
-                      assigned_reviewers_first, assigned_reviewers_second, 
-                      status, created_at, updated_at_utc, merged_at`
+            RETURNING id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+                      status, created_at, updated_at_utc, merged_at, closed_at, changed_files`
+	case entity.StatusClosed:
+		query = `
+            UPDATE pull_requests
+            SET status = $1, updated_at_utc = NOW(), closed_at = NOW()
+            WHERE pull_request_id = $2
+            RETURNING id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+                      status, created_at, updated_at_utc, merged_at, closed_at, changed_files`
+	case entity.StatusOpen, entity.StatusDraft:
+		query = `
+            UPDATE pull_requests
+            SET status = $1, updated_at_utc = NOW(), closed_at = NULL
+            WHERE pull_request_id = $2
+            RETURNING id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+                      status, created_at, updated_at_utc, merged_at, closed_at, changed_files`
+	default:
+		return nil, fmt.Errorf("unknown pull request status: %s", status)
 	}
 
 	args := []any{status, prID}
 
 	var pr entity.PullRequest
 	var err error
-	var rev1, rev2 sql.NullString
 
 	if tx != nil {
 		err = tx.QueryRowContext(ctx, query, args...).Scan(
 			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
 		)
 	} else {
 		err = ps.db.QueryRowContext(ctx, query, args...).Scan(
 			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
 		)
 	}
 
@@ -131,40 +245,31 @@ func (ps *PullRequestRepositoryImpl) UpdatePullRequestStatus(ctx context.Context
 		return nil, err
 	}
 
-	if rev1.Valid {
-		pr.AssignedReviewersFirst = rev1.String
-	}
-	if rev2.Valid {
-		pr.AssignedReviewersSecond = rev2.String
-	}
-
 	return &pr, nil
 }
 
 func (ps *PullRequestRepositoryImpl) GetPullRequestByID(ctx context.Context, tx *sql.Tx, prID string) (*entity.PullRequest, error) {
 	const query = `
-        SELECT id, author_id, pull_request_id, pull_request_name, 
-               assigned_reviewers_first, assigned_reviewers_second, 
-               status, created_at, updated_at_utc, merged_at
+        SELECT id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+               status, created_at, updated_at_utc, merged_at, closed_at, changed_files
         FROM pull_requests
         WHERE pull_request_id = $1
     `
 
 	var pr entity.PullRequest
 	var err error
-	var rev1, rev2 sql.NullString
 
 	if tx != nil {
 		err = tx.QueryRowContext(ctx, query, prID).Scan(
 			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
 		)
 	} else {
 		err = ps.db.QueryRowContext(ctx, query, prID).Scan(
 			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
 		)
 	}
 
@@ -172,23 +277,19 @@ func (ps *PullRequestRepositoryImpl) GetPullRequestByID(ctx context.Context, tx
 		return nil, err
 	}
 
-	if rev1.Valid {
-		pr.AssignedReviewersFirst = rev1.String
-	}
-	if rev2.Valid {
-		pr.AssignedReviewersSecond = rev2.String
-	}
-
 	return &pr, nil
 }
 
 func (ps *PullRequestRepositoryImpl) GetPullRequestsByReviewer(ctx context.Context, tx *sql.Tx, reviewerID string) ([]*entity.PullRequest, error) {
 	const query = `
-        SELECT id, author_id, pull_request_id, pull_request_name, 
-               assigned_reviewers_first, assigned_reviewers_second, 
-               status, created_at, updated_at_utc, merged_at
+        SELECT id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+               status, created_at, updated_at_utc, merged_at, closed_at, changed_files
         FROM pull_requests
-        WHERE assigned_reviewers_first = $1 OR assigned_reviewers_second = $1
+        WHERE EXISTS (
+            SELECT 1
+            FROM pull_request_reviewers r
+            WHERE r.pull_request_id = pull_requests.pull_request_id AND r.reviewer_id = $1
+        )
         ORDER BY created_at DESC
     `
 
@@ -209,22 +310,68 @@ func (ps *PullRequestRepositoryImpl) GetPullRequestsByReviewer(ctx context.Conte
 	var pullRequests []*entity.PullRequest
 	for rows.Next() {
 		var pr entity.PullRequest
-		var rev1, rev2 sql.NullString
 
 		err := rows.Scan(
 			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
-			&rev1, &rev2,
-			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		if rev1.Valid {
-			pr.AssignedReviewersFirst = rev1.String
-		}
-		if rev2.Valid {
-			pr.AssignedReviewersSecond = rev2.String
+		pullRequests = append(pullRequests, &pr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pullRequests, nil
+}
+
+func (ps *PullRequestRepositoryImpl) GetOpenPullRequestsByUsers(ctx context.Context, tx *sql.Tx, userIDs []string) ([]*entity.PullRequest, error) {
+	const query = `
+        SELECT id, author_id, pull_request_id, pull_request_name, ` + pullRequestReviewersColumn + `,
+               status, created_at, updated_at_utc, merged_at, closed_at, changed_files
+        FROM pull_requests
+        WHERE status IN ('OPEN', 'DRAFT')
+          AND (
+              author_id = ANY($1)
+              OR EXISTS (
+                  SELECT 1
+                  FROM pull_request_reviewers r
+                  WHERE r.pull_request_id = pull_requests.pull_request_id AND r.reviewer_id = ANY($1)
+              )
+          )
+        ORDER BY created_at
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, pq.Array(userIDs))
+	} else {
+		rows, err = ps.db.QueryContext(ctx, query, pq.Array(userIDs))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pullRequests []*entity.PullRequest
+	for rows.Next() {
+		var pr entity.PullRequest
+
+		err := rows.Scan(
+			&pr.ID, &pr.AuthorID, &pr.PullRequestID, &pr.PullRequestName,
+			pq.Array(&pr.AssignedReviewers),
+			&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.MergedAt, &pr.ClosedAt, pq.Array(&pr.ChangedFiles),
+		)
+		if err != nil {
+			return nil, err
 		}
 
 		pullRequests = append(pullRequests, &pr)
@@ -236,3 +383,129 @@ func (ps *PullRequestRepositoryImpl) GetPullRequestsByReviewer(ctx context.Conte
 
 	return pullRequests, nil
 }
+
+func (ps *PullRequestRepositoryImpl) CountOpenReviews(ctx context.Context, tx *sql.Tx, reviewerIDs []string) (map[string]int, error) {
+	const query = `
+        SELECT r.reviewer_id, COUNT(*)
+        FROM pull_request_reviewers r
+        JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+        WHERE pr.status = 'OPEN' AND r.reviewer_id = ANY($1)
+        GROUP BY r.reviewer_id
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, pq.Array(reviewerIDs))
+	} else {
+		rows, err = ps.db.QueryContext(ctx, query, pq.Array(reviewerIDs))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(reviewerIDs))
+	for rows.Next() {
+		var reviewerID string
+		var count int
+
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			return nil, err
+		}
+
+		counts[reviewerID] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (ps *PullRequestRepositoryImpl) GetSilentReviews(ctx context.Context, tx *sql.Tx) ([]*entity.SilentReview, error) {
+	const query = `
+        SELECT r.pull_request_id, pr.pull_request_name, pr.author_id, COALESCE(u.team_name, ''), r.reviewer_id,
+               EXTRACT(EPOCH FROM NOW() - GREATEST(r.assigned_at, pr.updated_at_utc))::BIGINT,
+               r.reminded_at IS NOT NULL AND r.reminded_at >= GREATEST(r.assigned_at, pr.updated_at_utc)
+        FROM pull_request_reviewers r
+        JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+        JOIN users u ON u.user_id = pr.author_id
+        WHERE pr.status = 'OPEN'
+          AND (r.escalated_at IS NULL OR r.escalated_at < GREATEST(r.assigned_at, pr.updated_at_utc))
+          AND NOT EXISTS (
+              SELECT 1
+              FROM pull_request_reviews rv
+              WHERE rv.pull_request_id = r.pull_request_id AND rv.reviewer_id = r.reviewer_id
+          )
+        ORDER BY r.pull_request_id, r.position
+        FOR UPDATE OF r SKIP LOCKED
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query)
+	} else {
+		rows, err = ps.db.QueryContext(ctx, query)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []*entity.SilentReview{}
+	for rows.Next() {
+		var review entity.SilentReview
+		var waitingSeconds int64
+
+		if err := rows.Scan(
+			&review.PullRequestID, &review.PullRequestName, &review.AuthorID, &review.TeamName, &review.ReviewerID,
+			&waitingSeconds, &review.Reminded,
+		); err != nil {
+			return nil, err
+		}
+
+		review.Waiting = time.Duration(waitingSeconds) * time.Second
+		reviews = append(reviews, &review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (ps *PullRequestRepositoryImpl) MarkReviewReminded(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error {
+	const query = `UPDATE pull_request_reviewers SET reminded_at = NOW() WHERE pull_request_id = $1 AND reviewer_id = $2`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, prID, reviewerID)
+	} else {
+		_, err = ps.db.ExecContext(ctx, query, prID, reviewerID)
+	}
+
+	return err
+}
+
+func (ps *PullRequestRepositoryImpl) MarkReviewEscalated(ctx context.Context, tx *sql.Tx, prID string, reviewerID string) error {
+	const query = `UPDATE pull_request_reviewers SET escalated_at = NOW() WHERE pull_request_id = $1 AND reviewer_id = $2`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, prID, reviewerID)
+	} else {
+		_, err = ps.db.ExecContext(ctx, query, prID, reviewerID)
+	}
+
+	return err
+}
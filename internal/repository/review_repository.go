@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type ReviewRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) *ReviewRepositoryImpl {
+	return &ReviewRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rr *ReviewRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return rr.db.BeginTx(ctx, nil)
+}
+
+func (rr *ReviewRepositoryImpl) UpsertReview(ctx context.Context, tx *sql.Tx, review *entity.Review) error {
+	const query = `
+        INSERT INTO pull_request_reviews (pull_request_id, reviewer_id, verdict, created_at, updated_at)
+        VALUES ($1, $2, $3, NOW(), NOW())
+        ON CONFLICT (pull_request_id, reviewer_id)
+        DO UPDATE SET verdict = EXCLUDED.verdict, updated_at = NOW()
+        RETURNING id, created_at, updated_at`
+
+	args := []any{review.PullRequestID, review.ReviewerID, review.Verdict}
+
+	if tx != nil {
+		return tx.QueryRowContext(ctx, query, args...).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	}
+	return rr.db.QueryRowContext(ctx, query, args...).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+}
+
+func (rr *ReviewRepositoryImpl) GetReviewsByPullRequest(ctx context.Context, tx *sql.Tx, prID string) ([]*entity.Review, error) {
+	const query = `
+        SELECT id, pull_request_id, reviewer_id, verdict, created_at, updated_at
+        FROM pull_request_reviews
+        WHERE pull_request_id = $1
+        ORDER BY updated_at
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, prID)
+	} else {
+		rows, err = rr.db.QueryContext(ctx, query, prID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []*entity.Review{}
+	for rows.Next() {
+		var review entity.Review
+		if err := rows.Scan(
+			&review.ID, &review.PullRequestID, &review.ReviewerID, &review.Verdict, &review.CreatedAt, &review.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, &review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type StatsRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) *StatsRepositoryImpl {
+	return &StatsRepositoryImpl{
+		db: db,
+	}
+}
+
+func (st *StatsRepositoryImpl) GetReviewerStats(ctx context.Context, tx *sql.Tx, filter entity.StatsFilter) ([]*entity.ReviewerStats, error) {
+	const query = `
+        WITH assigned AS (
+            SELECT r.reviewer_id,
+                   COUNT(*) FILTER (WHERE pr.status = 'OPEN') AS open_reviews,
+                   COUNT(*) FILTER (WHERE pr.status = 'MERGED') AS merged_reviews,
+                   PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at))
+                       FILTER (WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL) AS median_merge_seconds
+            FROM pull_request_reviewers r
+            JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+            WHERE ($2::timestamp IS NULL OR r.assigned_at >= $2)
+              AND ($3::timestamp IS NULL OR r.assigned_at < $3)
+            GROUP BY r.reviewer_id
+        ),
+        reassigned AS (
+            SELECT b.reviewer_id, COUNT(*) AS reassigned_away
+            FROM pull_request_events e
+            CROSS JOIN LATERAL jsonb_array_elements_text(e.before -> 'assigned_reviewers') AS b(reviewer_id)
+            WHERE e.action IN ('reviewer_reassigned', 'reviewer_removed')
+              AND NOT COALESCE(e.after -> 'assigned_reviewers', '[]'::jsonb) ? b.reviewer_id
+              AND ($2::timestamp IS NULL OR e.created_at >= $2)
+              AND ($3::timestamp IS NULL OR e.created_at < $3)
+            GROUP BY b.reviewer_id
+        )
+        SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active,
+               COALESCE(a.open_reviews, 0), COALESCE(a.merged_reviews, 0),
+               COALESCE(ra.reassigned_away, 0), a.median_merge_seconds
+        FROM users u
+        LEFT JOIN assigned a ON a.reviewer_id = u.user_id
+        LEFT JOIN reassigned ra ON ra.reviewer_id = u.user_id
+        WHERE ($1 = '' OR u.team_name = $1)
+        ORDER BY u.user_id
+    `
+
+	args := []any{filter.TeamName, filter.From, filter.To}
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = st.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []*entity.ReviewerStats{}
+	for rows.Next() {
+		var s entity.ReviewerStats
+		var medianSeconds sql.NullFloat64
+
+		if err := rows.Scan(
+			&s.UserID, &s.Username, &s.TeamName, &s.IsActive,
+			&s.OpenReviews, &s.MergedReviews, &s.ReassignedAway, &medianSeconds,
+		); err != nil {
+			return nil, err
+		}
+
+		s.MedianTimeToMerge = secondsToDuration(medianSeconds)
+
+		stats = append(stats, &s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (st *StatsRepositoryImpl) GetTeamWeeklyStats(ctx context.Context, tx *sql.Tx, filter entity.StatsFilter) ([]*entity.TeamWeekStats, error) {
+	const query = `
+        WITH prs AS (
+            SELECT COALESCE(u.team_name, '') AS team_name, pr.created_at, pr.merged_at, pr.status,
+                   COALESCE(created.after ->> 'status' = 'DRAFT', pr.status = 'DRAFT') AS created_as_draft,
+                   COALESCE(
+                       jsonb_array_length(created.after -> 'assigned_reviewers'),
+                       (SELECT COUNT(*) FROM pull_request_reviewers r WHERE r.pull_request_id = pr.pull_request_id)
+                   ) AS initial_reviewers
+            FROM pull_requests pr
+            JOIN users u ON u.user_id = pr.author_id
+            LEFT JOIN LATERAL (
+                SELECT e.after
+                FROM pull_request_events e
+                WHERE e.pull_request_id = pr.pull_request_id AND e.action = 'created'
+                ORDER BY e.id
+                LIMIT 1
+            ) created ON TRUE
+            WHERE ($1 = '' OR u.team_name = $1)
+        ),
+        opened AS (
+            SELECT team_name, DATE_TRUNC('week', created_at) AS week,
+                   COUNT(*) AS opened,
+                   COUNT(*) FILTER (WHERE NOT created_as_draft) AS opened_for_review,
+                   COUNT(*) FILTER (WHERE NOT created_as_draft AND initial_reviewers < 2) AS under_reviewed
+            FROM prs
+            WHERE ($2::timestamp IS NULL OR created_at >= $2)
+              AND ($3::timestamp IS NULL OR created_at < $3)
+            GROUP BY team_name, week
+        ),
+        merged AS (
+            SELECT team_name, DATE_TRUNC('week', merged_at) AS week,
+                   COUNT(*) AS merged,
+                   PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM merged_at - created_at)) AS median_seconds,
+                   PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM merged_at - created_at)) AS p90_seconds
+            FROM prs
+            WHERE status = 'MERGED' AND merged_at IS NOT NULL
+              AND ($2::timestamp IS NULL OR merged_at >= $2)
+              AND ($3::timestamp IS NULL OR merged_at < $3)
+            GROUP BY team_name, week
+        )
+        SELECT COALESCE(o.team_name, m.team_name) AS team_name, COALESCE(o.week, m.week) AS week,
+               COALESCE(o.opened, 0), COALESCE(m.merged, 0), m.median_seconds, m.p90_seconds,
+               COALESCE(o.opened_for_review, 0), COALESCE(o.under_reviewed, 0)
+        FROM opened o
+        FULL OUTER JOIN merged m ON m.team_name = o.team_name AND m.week = o.week
+        ORDER BY team_name, week
+    `
+
+	args := []any{filter.TeamName, filter.From, filter.To}
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = st.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []*entity.TeamWeekStats{}
+	for rows.Next() {
+		var s entity.TeamWeekStats
+		var medianSeconds, p90Seconds sql.NullFloat64
+
+		if err := rows.Scan(
+			&s.TeamName, &s.WeekStart, &s.Opened, &s.Merged, &medianSeconds, &p90Seconds,
+			&s.OpenedForReview, &s.UnderReviewed,
+		); err != nil {
+			return nil, err
+		}
+
+		s.MedianOpenToMerge = secondsToDuration(medianSeconds)
+		s.P90OpenToMerge = secondsToDuration(p90Seconds)
+
+		stats = append(stats, &s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func secondsToDuration(seconds sql.NullFloat64) *time.Duration {
+	if !seconds.Valid {
+		return nil
+	}
+
+	duration := time.Duration(seconds.Float64 * float64(time.Second))
+	return &duration
+}
@@ -5,9 +5,19 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/oooooorg/PR-Service/internal/entity"
 )
 
+const teamFallbackTeamsColumn = `
+        ARRAY(
+            SELECT f.fallback_team_name
+            FROM team_fallback_teams f
+            WHERE f.team_name = teams.team_name
+            ORDER BY f.position
+        )`
+
 type TeamRepositoryImpl struct {
 	db *sql.DB
 }
@@ -23,29 +33,80 @@ func (tr *TeamRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
 }
 
 func (tr *TeamRepositoryImpl) CreateTeam(ctx context.Context, tx *sql.Tx, team *entity.Team) error {
-	const query = `INSERT INTO teams (team_name) VALUES ($1) RETURNING id`
+	const query = `
+        INSERT INTO teams (team_name, required_reviewers, required_approvals, block_on_changes_requested, allow_self_approval)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id`
+
+	args := []any{team.TeamName, team.RequiredReviewers, team.RequiredApprovals, team.BlockOnChangesRequested, team.AllowSelfApproval}
+
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&team.ID)
+	} else {
+		err = tr.db.QueryRowContext(ctx, query, args...).Scan(&team.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tr.SetFallbackTeams(ctx, tx, team.TeamName, team.FallbackTeams)
+}
+
+func (tr *TeamRepositoryImpl) SetFallbackTeams(ctx context.Context, tx *sql.Tx, teamName string, fallbackTeams []string) error {
+	const deleteQuery = `DELETE FROM team_fallback_teams WHERE team_name = $1`
+	const insertQuery = `
+        INSERT INTO team_fallback_teams (team_name, fallback_team_name, position)
+        SELECT $1, fallback_team_name, position
+        FROM UNNEST($2::VARCHAR[]) WITH ORDINALITY AS fallbacks(fallback_team_name, position)`
 
 	var err error
 
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, team.TeamName).Scan(&team.ID)
+		_, err = tx.ExecContext(ctx, deleteQuery, teamName)
+	} else {
+		_, err = tr.db.ExecContext(ctx, deleteQuery, teamName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, insertQuery, teamName, pq.Array(fallbackTeams))
 	} else {
-		err = tr.db.QueryRowContext(ctx, query, team.TeamName).Scan(&team.ID)
+		_, err = tr.db.ExecContext(ctx, insertQuery, teamName, pq.Array(fallbackTeams))
 	}
 
 	return err
 }
 
 func (tr *TeamRepositoryImpl) GetTeamByName(ctx context.Context, tx *sql.Tx, teamName string) (*entity.Team, error) {
-	const query = `SELECT id, team_name FROM teams WHERE team_name = $1`
+	const query = `
+        SELECT id, team_name, required_reviewers, required_approvals, block_on_changes_requested, allow_self_approval,
+               ` + teamFallbackTeamsColumn + `
+        FROM teams
+        WHERE team_name = $1`
 
 	var team entity.Team
 	var err error
 
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, teamName).Scan(&team.ID, &team.TeamName)
+		err = tx.QueryRowContext(ctx, query, teamName).Scan(
+			&team.ID, &team.TeamName, &team.RequiredReviewers, &team.RequiredApprovals, &team.BlockOnChangesRequested, &team.AllowSelfApproval,
+			pq.Array(&team.FallbackTeams),
+		)
 	} else {
-		err = tr.db.QueryRowContext(ctx, query, teamName).Scan(&team.ID, &team.TeamName)
+		err = tr.db.QueryRowContext(ctx, query, teamName).Scan(
+			&team.ID, &team.TeamName, &team.RequiredReviewers, &team.RequiredApprovals, &team.BlockOnChangesRequested, &team.AllowSelfApproval,
+			pq.Array(&team.FallbackTeams),
+		)
 	}
 
 	if err != nil {
@@ -55,6 +116,65 @@ func (tr *TeamRepositoryImpl) GetTeamByName(ctx context.Context, tx *sql.Tx, tea
 	return &team, nil
 }
 
+func (tr *TeamRepositoryImpl) UpdateTeam(ctx context.Context, tx *sql.Tx, teamName string, team *entity.Team) error {
+	const query = `
+        UPDATE teams
+        SET team_name = $1, required_reviewers = $2, required_approvals = $3,
+            block_on_changes_requested = $4, allow_self_approval = $5
+        WHERE team_name = $6
+        RETURNING id`
+
+	args := []any{
+		team.TeamName,
+		team.RequiredReviewers,
+		team.RequiredApprovals,
+		team.BlockOnChangesRequested,
+		team.AllowSelfApproval,
+		teamName,
+	}
+
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&team.ID)
+	} else {
+		err = tr.db.QueryRowContext(ctx, query, args...).Scan(&team.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tr.SetFallbackTeams(ctx, tx, team.TeamName, team.FallbackTeams)
+}
+
+func (tr *TeamRepositoryImpl) DeleteTeam(ctx context.Context, tx *sql.Tx, teamName string) error {
+	const query = `DELETE FROM teams WHERE team_name = $1`
+
+	var res sql.Result
+	var err error
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, teamName)
+	} else {
+		res, err = tr.db.ExecContext(ctx, query, teamName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func (tr *TeamRepositoryImpl) TeamExists(ctx context.Context, tx *sql.Tx, teamName string) (bool, error) {
 	const query = `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`
 
@@ -73,3 +193,119 @@ func (tr *TeamRepositoryImpl) TeamExists(ctx context.Context, tx *sql.Tx, teamNa
 
 	return exists, nil
 }
+
+// GetRoundRobinCursor returns the last user picked by round-robin in the team
+// and locks the team row, so concurrent assignments take turns.
+func (tr *TeamRepositoryImpl) GetRoundRobinCursor(ctx context.Context, tx *sql.Tx, teamName string) (string, error) {
+	const query = `
+        SELECT COALESCE(round_robin_cursor, '')
+        FROM teams
+        WHERE team_name = $1
+        FOR UPDATE`
+
+	var cursor string
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, teamName).Scan(&cursor)
+	} else {
+		err = tr.db.QueryRowContext(ctx, query, teamName).Scan(&cursor)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return cursor, nil
+}
+
+func (tr *TeamRepositoryImpl) SetRoundRobinCursor(ctx context.Context, tx *sql.Tx, teamName string, userID string) error {
+	const query = `UPDATE teams SET round_robin_cursor = $2 WHERE team_name = $1`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, teamName, userID)
+	} else {
+		_, err = tr.db.ExecContext(ctx, query, teamName, userID)
+	}
+
+	return err
+}
+
+func (tr *TeamRepositoryImpl) GetOwnershipRules(ctx context.Context, tx *sql.Tx, teamName string) ([]*entity.OwnershipRule, error) {
+	const query = `
+        SELECT id, team_name, pattern, owners, position
+        FROM team_ownership_rules
+        WHERE team_name = $1
+        ORDER BY position
+    `
+
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, teamName)
+	} else {
+		rows, err = tr.db.QueryContext(ctx, query, teamName)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*entity.OwnershipRule
+	for rows.Next() {
+		var rule entity.OwnershipRule
+		if err := rows.Scan(&rule.ID, &rule.TeamName, &rule.Pattern, pq.Array(&rule.Owners), &rule.Position); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (tr *TeamRepositoryImpl) SetOwnershipRules(ctx context.Context, tx *sql.Tx, teamName string, rules []*entity.OwnershipRule) error {
+	const deleteQuery = `DELETE FROM team_ownership_rules WHERE team_name = $1`
+	const insertQuery = `
+        INSERT INTO team_ownership_rules (team_name, pattern, owners, position)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, deleteQuery, teamName)
+	} else {
+		_, err = tr.db.ExecContext(ctx, deleteQuery, teamName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for i, rule := range rules {
+		rule.TeamName = teamName
+		rule.Position = i + 1
+
+		args := []any{teamName, rule.Pattern, pq.Array(rule.Owners), rule.Position}
+
+		if tx != nil {
+			err = tx.QueryRowContext(ctx, insertQuery, args...).Scan(&rule.ID)
+		} else {
+			err = tr.db.QueryRowContext(ctx, insertQuery, args...).Scan(&rule.ID)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -22,9 +22,9 @@ func (ur *UserRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
 }
 
 func (ur *UserRepositoryImpl) CreateUser(ctx context.Context, tx *sql.Tx, user *entity.User) error {
-	const query = `INSERT INTO users (user_id, username, is_active, team_name) VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+	const query = `INSERT INTO users (user_id, username, is_active, team_name, max_open_reviews) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
 
-	args := []any{user.UserID, user.Username, user.IsActive, user.TeamName}
+	args := []any{user.UserID, user.Username, user.IsActive, user.TeamName, user.MaxOpenReviews}
 
 	if tx != nil {
 		return tx.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.CreatedAt)
@@ -33,7 +33,7 @@ func (ur *UserRepositoryImpl) CreateUser(ctx context.Context, tx *sql.Tx, user *
 }
 
 func (ur *UserRepositoryImpl) SetUserActive(ctx context.Context, tx *sql.Tx, userID string, isActive bool) (*entity.User, error) {
-	const query = `UPDATE users SET is_active = $1, updated_at = NOW() WHERE user_id = $2 RETURNING id, user_id, username, team_name, is_active, created_at, updated_at`
+	const query = `UPDATE users SET is_active = $1, updated_at = NOW() WHERE user_id = $2 RETURNING id, user_id, username, COALESCE(team_name, ''), is_active, max_open_reviews, created_at, updated_at`
 
 	args := []any{isActive, userID}
 
@@ -42,11 +42,11 @@ func (ur *UserRepositoryImpl) SetUserActive(ctx context.Context, tx *sql.Tx, use
 
 	if tx != nil {
 		err = tx.QueryRowContext(ctx, query, args...).Scan(
-			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt,
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
 		)
 	} else {
 		err = ur.db.QueryRowContext(ctx, query, args...).Scan(
-			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt,
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
 		)
 	}
 
@@ -59,7 +59,7 @@ func (ur *UserRepositoryImpl) SetUserActive(ctx context.Context, tx *sql.Tx, use
 
 func (ur *UserRepositoryImpl) GetUsersByTeam(ctx context.Context, tx *sql.Tx, teamName string) ([]*entity.User, error) {
 	const query = `
-        SELECT id, user_id, username, team_name, is_active, created_at, updated_at
+        SELECT id, user_id, username, team_name, is_active, max_open_reviews, created_at, updated_at
         FROM users
         WHERE team_name = $1
     `
@@ -83,7 +83,7 @@ func (ur *UserRepositoryImpl) GetUsersByTeam(ctx context.Context, tx *sql.Tx, te
 	var users []*entity.User
 	for rows.Next() {
 		var user entity.User
-		if err := rows.Scan(&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt); err != nil {
+		if err := rows.Scan(&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt); err != nil {
 			return nil, err
 		}
 		users = append(users, &user)
@@ -98,7 +98,7 @@ func (ur *UserRepositoryImpl) GetUsersByTeam(ctx context.Context, tx *sql.Tx, te
 
 func (ur *UserRepositoryImpl) GetUserByID(ctx context.Context, tx *sql.Tx, userID string) (*entity.User, error) {
 	const query = `
-        SELECT id, user_id, username, team_name, is_active, created_at, updated_at
+        SELECT id, user_id, username, COALESCE(team_name, ''), is_active, max_open_reviews, created_at, updated_at
         FROM users
         WHERE user_id = $1
     `
@@ -110,11 +110,11 @@ func (ur *UserRepositoryImpl) GetUserByID(ctx context.Context, tx *sql.Tx, userI
 
 	if tx != nil {
 		err = tx.QueryRowContext(ctx, query, args...).Scan(
-			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt,
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
 		)
 	} else {
 		err = ur.db.QueryRowContext(ctx, query, args...).Scan(
-			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt,
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
 		)
 	}
 
@@ -124,3 +124,102 @@ func (ur *UserRepositoryImpl) GetUserByID(ctx context.Context, tx *sql.Tx, userI
 
 	return &user, nil
 }
+
+func (ur *UserRepositoryImpl) SetUserTeam(ctx context.Context, tx *sql.Tx, userID string, teamName string) (*entity.User, error) {
+	const query = `
+        UPDATE users
+        SET team_name = $1, updated_at = NOW()
+        WHERE user_id = $2
+        RETURNING id, user_id, username, COALESCE(team_name, ''), is_active, max_open_reviews, created_at, updated_at`
+
+	args := []any{teamName, userID}
+
+	var user entity.User
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	} else {
+		err = ur.db.QueryRowContext(ctx, query, args...).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (ur *UserRepositoryImpl) SetUserMaxOpenReviews(ctx context.Context, tx *sql.Tx, userID string, maxOpenReviews *int) (*entity.User, error) {
+	const query = `
+        UPDATE users
+        SET max_open_reviews = $1, updated_at = NOW()
+        WHERE user_id = $2
+        RETURNING id, user_id, username, COALESCE(team_name, ''), is_active, max_open_reviews, created_at, updated_at`
+
+	args := []any{maxOpenReviews, userID}
+
+	var user entity.User
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	} else {
+		err = ur.db.QueryRowContext(ctx, query, args...).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (ur *UserRepositoryImpl) RemoveUserFromTeam(ctx context.Context, tx *sql.Tx, userID string) (*entity.User, error) {
+	const query = `
+        UPDATE users
+        SET team_name = NULL, is_active = false, updated_at = NOW()
+        WHERE user_id = $1
+        RETURNING id, user_id, username, COALESCE(team_name, ''), is_active, max_open_reviews, created_at, updated_at`
+
+	var user entity.User
+	var err error
+
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	} else {
+		err = ur.db.QueryRowContext(ctx, query, userID).Scan(
+			&user.ID, &user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.MaxOpenReviews, &user.CreatedAt, &user.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (ur *UserRepositoryImpl) DeactivateUsersByTeam(ctx context.Context, tx *sql.Tx, teamName string) error {
+	const query = `UPDATE users SET is_active = false, updated_at = NOW() WHERE team_name = $1`
+
+	var err error
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, teamName)
+	} else {
+		_, err = ur.db.ExecContext(ctx, query, teamName)
+	}
+
+	return err
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type WebhookRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepositoryImpl {
+	return &WebhookRepositoryImpl{
+		db: db,
+	}
+}
+
+func (wr *WebhookRepositoryImpl) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return wr.db.BeginTx(ctx, nil)
+}
+
+func (wr *WebhookRepositoryImpl) CreateSubscription(ctx context.Context, tx *sql.Tx, subscription *entity.WebhookSubscription) error {
+	const query = `
+        INSERT INTO webhook_subscriptions (url, secret, events, created_at)
+        VALUES ($1, $2, $3, NOW())
+        RETURNING id, created_at`
+
+	args := []any{subscription.URL, subscription.Secret, pq.Array(subscription.Events)}
+
+	if tx != nil {
+		return tx.QueryRowContext(ctx, query, args...).Scan(&subscription.ID, &subscription.CreatedAt)
+	}
+	return wr.db.QueryRowContext(ctx, query, args...).Scan(&subscription.ID, &subscription.CreatedAt)
+}
+
+func (wr *WebhookRepositoryImpl) GetSubscriptions(ctx context.Context, tx *sql.Tx) ([]*entity.WebhookSubscription, error) {
+	const query = `
+        SELECT id, url, secret, events, created_at
+        FROM webhook_subscriptions
+        ORDER BY id
+    `
+
+	return wr.querySubscriptions(ctx, tx, query)
+}
+
+func (wr *WebhookRepositoryImpl) GetSubscriptionsByEvent(ctx context.Context, tx *sql.Tx, event string) ([]*entity.WebhookSubscription, error) {
+	const query = `
+        SELECT id, url, secret, events, created_at
+        FROM webhook_subscriptions
+        WHERE $1 = ANY(events)
+        ORDER BY id
+    `
+
+	return wr.querySubscriptions(ctx, tx, query, event)
+}
+
+func (wr *WebhookRepositoryImpl) DeleteSubscription(ctx context.Context, tx *sql.Tx, subscriptionID int) error {
+	const query = `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	var res sql.Result
+	var err error
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, subscriptionID)
+	} else {
+		res, err = wr.db.ExecContext(ctx, query, subscriptionID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (wr *WebhookRepositoryImpl) querySubscriptions(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*entity.WebhookSubscription, error) {
+	var rows *sql.Rows
+	var err error
+
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = wr.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := []*entity.WebhookSubscription{}
+	for rows.Next() {
+		var subscription entity.WebhookSubscription
+		if err := rows.Scan(
+			&subscription.ID, &subscription.URL, &subscription.Secret, pq.Array(&subscription.Events), &subscription.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
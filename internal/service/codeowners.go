@@ -0,0 +1,66 @@
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func parseCodeowners(content string) ([]*entity.OwnershipRule, error) {
+	var rules []*entity.OwnershipRule
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(stripCodeownersComment(scanner.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := strings.ReplaceAll(fields[0], `\#`, "#")
+		if err := validateOwnershipPattern(pattern); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		owners := make([]string, 0, len(fields)-1)
+		for _, owner := range fields[1:] {
+			owners = append(owners, strings.TrimPrefix(owner, "@"))
+		}
+
+		rules = append(rules, &entity.OwnershipRule{
+			Pattern: pattern,
+			Owners:  owners,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func stripCodeownersComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] != '\\') {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func validateOwnershipPattern(pattern string) error {
+	if strings.Trim(pattern, "/") == "" {
+		return fmt.Errorf("%w: empty pattern", ErrInvalidOwnershipRule)
+	}
+
+	for _, part := range strings.Split(strings.Trim(pattern, "/"), "/") {
+		if _, err := path.Match(part, ""); err != nil {
+			return fmt.Errorf("%w: bad pattern %s", ErrInvalidOwnershipRule, pattern)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func TestParseCodeowners(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.OwnershipRule
+		wantErr string
+	}{
+		{
+			name:    "comments and blank lines skipped",
+			content: "# owners of the repo\n\n   \n*.go @u1 # backend\n",
+			want:    []*entity.OwnershipRule{{Pattern: "*.go", Owners: []string{"u1"}}},
+		},
+		{
+			name:    "escaped hash is literal",
+			content: `/docs/\#drafts/ @u1 # not an owner`,
+			want:    []*entity.OwnershipRule{{Pattern: "/docs/#drafts/", Owners: []string{"u1"}}},
+		},
+		{
+			name:    "multiple owners with and without at sign",
+			content: "/api/ @u1 u2\t@u3",
+			want:    []*entity.OwnershipRule{{Pattern: "/api/", Owners: []string{"u1", "u2", "u3"}}},
+		},
+		{
+			name:    "rule without owners",
+			content: "/vendor/",
+			want:    []*entity.OwnershipRule{{Pattern: "/vendor/", Owners: []string{}}},
+		},
+		{
+			name:    "order preserved so the last match wins",
+			content: "* @u1\n/docs/ @u2\n/docs/api.md @u3\n",
+			want: []*entity.OwnershipRule{
+				{Pattern: "*", Owners: []string{"u1"}},
+				{Pattern: "/docs/", Owners: []string{"u2"}},
+				{Pattern: "/docs/api.md", Owners: []string{"u3"}},
+			},
+		},
+		{
+			name:    "empty file",
+			content: "# nothing here\n",
+			want:    nil,
+		},
+		{
+			name:    "bad pattern reports line",
+			content: "*.go @u1\n/src/[ @u2\n",
+			wantErr: "line 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCodeowners(tt.content)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidOwnershipRule)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCodeowners_LastMatchWins(t *testing.T) {
+	rules, err := parseCodeowners("* @u1\n/docs/ @u2\n/docs/api.md @u3\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u3"}, ownersForFiles(rules, []string{"docs/api.md"}))
+	assert.Equal(t, []string{"u2"}, ownersForFiles(rules, []string{"docs/guide.md"}))
+}
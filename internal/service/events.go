@@ -0,0 +1,70 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func reviewerEvents(eventType entity.EventType, pr *entity.PullRequest, reviewerIDs []string) []entity.Event {
+	occurredAt := time.Now().UTC()
+
+	events := make([]entity.Event, 0, len(reviewerIDs))
+	for _, reviewerID := range reviewerIDs {
+		events = append(events, entity.Event{
+			Type:            eventType,
+			OccurredAt:      occurredAt,
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			ReviewerID:      reviewerID,
+		})
+	}
+
+	return events
+}
+
+func reassignmentEvents(pr *entity.PullRequest, oldReviewerID string, newReviewerID *string) []entity.Event {
+	unassigned := reviewerEvents(entity.EventReviewerUnassigned, pr, []string{oldReviewerID})
+	if newReviewerID == nil {
+		return unassigned
+	}
+
+	unassigned[0].NewReviewerID = *newReviewerID
+
+	assigned := reviewerEvents(entity.EventReviewerAssigned, pr, []string{*newReviewerID})
+	assigned[0].OldReviewerID = oldReviewerID
+
+	return append(unassigned, assigned...)
+}
+
+// reviewerChangeEvents reports reviewers dropped and added between two states
+// of the same pull request.
+func reviewerChangeEvents(before, after *entity.PullRequest) []entity.Event {
+	return append(
+		reviewerEvents(entity.EventReviewerUnassigned, after, addedReviewers(after.AssignedReviewers, before.AssignedReviewers)),
+		reviewerEvents(entity.EventReviewerAssigned, after, addedReviewers(before.AssignedReviewers, after.AssignedReviewers))...,
+	)
+}
+
+func mergedEvent(pr *entity.PullRequest) entity.Event {
+	return entity.Event{
+		Type:              entity.EventPullRequestMerged,
+		OccurredAt:        time.Now().UTC(),
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		AssignedReviewers: pr.AssignedReviewers,
+	}
+}
+
+func addedReviewers(before, after []string) []string {
+	added := []string{}
+	for _, reviewerID := range after {
+		if !slices.Contains(before, reviewerID) {
+			added = append(added, reviewerID)
+		}
+	}
+	return added
+}
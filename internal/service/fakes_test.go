@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+// In-memory repositories for service tests. Each embeds the interface it
+// fakes, so calling a method a test did not expect panics instead of
+// silently succeeding.
+
+// noopDriver gives fakes a real *sql.Tx whose Commit and Rollback do nothing.
+type noopDriver struct{}
+
+type noopConn struct{}
+
+type noopTx struct{}
+
+func (noopDriver) Open(string) (driver.Conn, error) { return noopConn{}, nil }
+
+func (noopConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("noop driver does not run queries")
+}
+
+func (noopConn) Close() error { return nil }
+
+func (noopConn) Begin() (driver.Tx, error) { return noopTx{}, nil }
+
+func (noopTx) Commit() error { return nil }
+
+func (noopTx) Rollback() error { return nil }
+
+var noopDB = sql.OpenDB(noopConnector{})
+
+type noopConnector struct{}
+
+func (noopConnector) Connect(context.Context) (driver.Conn, error) { return noopConn{}, nil }
+
+func (noopConnector) Driver() driver.Driver { return noopDriver{} }
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[string]*entity.User
+}
+
+func newFakeUserRepo(users ...*entity.User) *fakeUserRepo {
+	repo := &fakeUserRepo{users: map[string]*entity.User{}}
+	for _, user := range users {
+		repo.users[user.UserID] = user
+	}
+	return repo
+}
+
+func (f *fakeUserRepo) GetUserByID(_ context.Context, _ *sql.Tx, userID string) (*entity.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return user, nil
+}
+
+func (f *fakeUserRepo) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return noopDB.BeginTx(ctx, nil)
+}
+
+func (f *fakeUserRepo) SetUserTeam(_ context.Context, _ *sql.Tx, userID string, teamName string) (*entity.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	user.TeamName = teamName
+	return user, nil
+}
+
+func (f *fakeUserRepo) GetUsersByTeam(_ context.Context, _ *sql.Tx, teamName string) ([]*entity.User, error) {
+	var users []*entity.User
+	for _, user := range f.users {
+		if user.TeamName == teamName {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].UserID < users[j].UserID
+	})
+	return users, nil
+}
+
+type fakeTeamRepo struct {
+	repository.TeamRepository
+	teams   map[string]*entity.Team
+	cursors map[string]string
+}
+
+func newFakeTeamRepo(teams ...*entity.Team) *fakeTeamRepo {
+	repo := &fakeTeamRepo{teams: map[string]*entity.Team{}, cursors: map[string]string{}}
+	for _, team := range teams {
+		repo.teams[team.TeamName] = team
+	}
+	return repo
+}
+
+func (f *fakeTeamRepo) GetTeamByName(_ context.Context, _ *sql.Tx, teamName string) (*entity.Team, error) {
+	team, ok := f.teams[teamName]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return team, nil
+}
+
+func (f *fakeTeamRepo) GetRoundRobinCursor(_ context.Context, _ *sql.Tx, teamName string) (string, error) {
+	return f.cursors[teamName], nil
+}
+
+func (f *fakeTeamRepo) SetRoundRobinCursor(_ context.Context, _ *sql.Tx, teamName string, userID string) error {
+	f.cursors[teamName] = userID
+	return nil
+}
+
+func (f *fakeTeamRepo) TeamExists(_ context.Context, _ *sql.Tx, teamName string) (bool, error) {
+	_, ok := f.teams[teamName]
+	return ok, nil
+}
+
+type fakePullRequestRepo struct {
+	repository.PullRequestRepository
+	prs       map[string]*entity.PullRequest
+	silent    []*entity.SilentReview
+	loads     map[string]int
+	reminded  []string
+	escalated []string
+}
+
+func newFakePullRequestRepo(prs ...*entity.PullRequest) *fakePullRequestRepo {
+	repo := &fakePullRequestRepo{prs: map[string]*entity.PullRequest{}}
+	for _, pr := range prs {
+		repo.prs[pr.PullRequestID] = pr
+	}
+	return repo
+}
+
+func (f *fakePullRequestRepo) GetPullRequestByID(_ context.Context, _ *sql.Tx, prID string) (*entity.PullRequest, error) {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return clonePullRequest(pr), nil
+}
+
+func (f *fakePullRequestRepo) UpdatePullRequestStatus(_ context.Context, _ *sql.Tx, prID string, status string) (*entity.PullRequest, error) {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	pr.Status = entity.PullRequestStatus(status)
+	return clonePullRequest(pr), nil
+}
+
+func (f *fakePullRequestRepo) SetPullRequestReviewers(_ context.Context, _ *sql.Tx, prID string, reviewerIDs []string) error {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	pr.AssignedReviewers = slices.Clone(reviewerIDs)
+	return nil
+}
+
+func (f *fakePullRequestRepo) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return noopDB.BeginTx(ctx, nil)
+}
+
+func (f *fakePullRequestRepo) ReplacePullRequestReviewer(_ context.Context, _ *sql.Tx, prID string, oldReviewerID, newReviewerID string) (*entity.PullRequest, error) {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	for i, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == oldReviewerID {
+			pr.AssignedReviewers[i] = newReviewerID
+		}
+	}
+	return clonePullRequest(pr), nil
+}
+
+func (f *fakePullRequestRepo) GetPullRequestsByReviewer(_ context.Context, _ *sql.Tx, reviewerID string) ([]*entity.PullRequest, error) {
+	var prs []*entity.PullRequest
+	for _, pr := range f.prs {
+		if slices.Contains(pr.AssignedReviewers, reviewerID) {
+			prs = append(prs, clonePullRequest(pr))
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		return prs[i].PullRequestID < prs[j].PullRequestID
+	})
+	return prs, nil
+}
+
+func (f *fakePullRequestRepo) RemovePullRequestReviewer(_ context.Context, _ *sql.Tx, prID string, reviewerID string) error {
+	pr, ok := f.prs[prID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	pr.AssignedReviewers = slices.DeleteFunc(pr.AssignedReviewers, func(id string) bool {
+		return id == reviewerID
+	})
+	return nil
+}
+
+func (f *fakePullRequestRepo) CountOpenReviews(_ context.Context, _ *sql.Tx, userIDs []string) (map[string]int, error) {
+	loads := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		loads[userID] = f.loads[userID]
+	}
+	return loads, nil
+}
+
+func (f *fakePullRequestRepo) GetSilentReviews(context.Context, *sql.Tx) ([]*entity.SilentReview, error) {
+	return f.silent, nil
+}
+
+func (f *fakePullRequestRepo) MarkReviewReminded(_ context.Context, _ *sql.Tx, prID string, reviewerID string) error {
+	f.reminded = append(f.reminded, prID+"/"+reviewerID)
+	return nil
+}
+
+func (f *fakePullRequestRepo) MarkReviewEscalated(_ context.Context, _ *sql.Tx, prID string, reviewerID string) error {
+	f.escalated = append(f.escalated, prID+"/"+reviewerID)
+	return nil
+}
+
+func clonePullRequest(pr *entity.PullRequest) *entity.PullRequest {
+	clone := *pr
+	clone.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	return &clone
+}
+
+type fakeAbsenceRepo struct {
+	repository.AbsenceRepository
+	absent     []string
+	started    []*entity.Absence
+	handedOver []int
+}
+
+func (f *fakeAbsenceRepo) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return noopDB.BeginTx(ctx, nil)
+}
+
+func (f *fakeAbsenceRepo) GetStartedAbsences(context.Context, *sql.Tx) ([]*entity.Absence, error) {
+	return f.started, nil
+}
+
+func (f *fakeAbsenceRepo) MarkAbsenceHandedOver(_ context.Context, _ *sql.Tx, absenceID int) error {
+	f.handedOver = append(f.handedOver, absenceID)
+	return nil
+}
+
+func (f *fakeAbsenceRepo) GetAbsentUserIDs(_ context.Context, _ *sql.Tx, userIDs []string) ([]string, error) {
+	var absent []string
+	for _, userID := range userIDs {
+		if slices.Contains(f.absent, userID) {
+			absent = append(absent, userID)
+		}
+	}
+	return absent, nil
+}
+
+type failedOutboxEvent struct {
+	deliveredTargets []string
+	reason           string
+	retryAfter       time.Duration
+}
+
+type fakeOutboxRepo struct {
+	repository.OutboxRepository
+	pending   []*entity.OutboxEntry
+	added     []entity.Event
+	delivered []int64
+	failed    map[int64]failedOutboxEvent
+	dead      map[int64]failedOutboxEvent
+	lease     time.Duration
+	txs       int
+}
+
+func newFakeOutboxRepo(pending ...*entity.OutboxEntry) *fakeOutboxRepo {
+	return &fakeOutboxRepo{
+		pending: pending,
+		failed:  map[int64]failedOutboxEvent{},
+		dead:    map[int64]failedOutboxEvent{},
+	}
+}
+
+func (f *fakeOutboxRepo) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	f.txs++
+	return noopDB.BeginTx(ctx, nil)
+}
+
+func (f *fakeOutboxRepo) AddEvents(_ context.Context, _ *sql.Tx, events []entity.Event) error {
+	f.added = append(f.added, events...)
+	return nil
+}
+
+func (f *fakeOutboxRepo) ClaimPendingEvents(_ context.Context, _ *sql.Tx, limit int, lease time.Duration) ([]*entity.OutboxEntry, error) {
+	f.lease = lease
+	return f.pending[:min(limit, len(f.pending))], nil
+}
+
+func (f *fakeOutboxRepo) MarkEventsDelivered(_ context.Context, _ *sql.Tx, eventIDs []int64) error {
+	f.delivered = append(f.delivered, eventIDs...)
+	return nil
+}
+
+func (f *fakeOutboxRepo) MarkEventFailed(_ context.Context, _ *sql.Tx, eventID int64, deliveredTargets []string, reason string, retryAfter time.Duration) error {
+	f.failed[eventID] = failedOutboxEvent{deliveredTargets: deliveredTargets, reason: reason, retryAfter: retryAfter}
+	return nil
+}
+
+func (f *fakeOutboxRepo) MarkEventDead(_ context.Context, _ *sql.Tx, eventID int64, deliveredTargets []string, reason string) error {
+	f.dead[eventID] = failedOutboxEvent{deliveredTargets: deliveredTargets, reason: reason}
+	return nil
+}
+
+type fakeHistoryRepo struct {
+	repository.PullRequestHistoryRepository
+	entries []entity.PullRequestHistoryEntry
+}
+
+func (f *fakeHistoryRepo) AddEntries(_ context.Context, _ *sql.Tx, entries []entity.PullRequestHistoryEntry) error {
+	f.entries = append(f.entries, entries...)
+	return nil
+}
+
+type fakeReviewRepo struct {
+	repository.ReviewRepository
+}
+
+func (f *fakeReviewRepo) GetReviewsByPullRequest(context.Context, *sql.Tx, string) ([]*entity.Review, error) {
+	return nil, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+)
+
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+var ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+var ErrWebhookEventIgnored = errors.New("webhook event ignored")
+var ErrUnknownWebhookUser = errors.New("webhook user is not mapped to a user_id")
+
+type gitHubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+type GitHubWebhookServiceImpl struct {
+	logger    *slog.Logger
+	prService PullRequestService
+	cfg       config.GitHubConfig
+}
+
+func NewGitHubWebhookService(logger *slog.Logger, prService PullRequestService, cfg config.GitHubConfig) GitHubWebhookService {
+	return &GitHubWebhookServiceImpl{
+		logger:    logger,
+		prService: prService,
+		cfg:       cfg,
+	}
+}
+
+func (g *GitHubWebhookServiceImpl) HandleEvent(ctx context.Context, eventType string, signature string, payload []byte) (*models.PullRequest, error) {
+	if !g.validSignature(signature, payload) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	if eventType != "pull_request" {
+		return nil, fmt.Errorf("%w: event %s", ErrWebhookEventIgnored, eventType)
+	}
+
+	var event gitHubPullRequestEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookPayload, err.Error())
+	}
+	if event.Repository.FullName == "" || event.Number == 0 {
+		return nil, fmt.Errorf("%w: repository and number are required", ErrInvalidWebhookPayload)
+	}
+
+	prID := fmt.Sprintf("%s#%d", event.Repository.FullName, event.Number)
+
+	g.logger.Info("GitHub pull request event",
+		slog.String("action", event.Action),
+		slog.String("pull_request_id", prID),
+	)
+
+	switch event.Action {
+	case "opened":
+		authorID, ok := g.cfg.UserIDForLogin(event.PullRequest.User.Login)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownWebhookUser, event.PullRequest.User.Login)
+		}
+
+		draft := event.PullRequest.Draft
+		return g.prService.CreatePullRequest(ctx, &api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: event.PullRequest.Title,
+			AuthorId:        authorID,
+			Draft:           &draft,
+		})
+	case "closed":
+		if event.PullRequest.Merged {
+			return g.prService.RecordExternalMerge(ctx, &api.PostPullRequestMergeJSONRequestBody{PullRequestId: prID})
+		}
+		return g.prService.ClosePullRequest(ctx, &api.PostPullRequestCloseJSONRequestBody{PullRequestId: prID})
+	case "reopened":
+		return g.prService.ReopenPullRequest(ctx, &api.PostPullRequestReopenJSONRequestBody{PullRequestId: prID})
+	case "ready_for_review":
+		return g.prService.MarkPullRequestReady(ctx, &api.PostPullRequestReadyJSONRequestBody{PullRequestId: prID})
+	case "converted_to_draft":
+		return g.prService.MarkPullRequestDraft(ctx, &api.PostPullRequestDraftJSONRequestBody{PullRequestId: prID})
+	}
+
+	return nil, fmt.Errorf("%w: action %s", ErrWebhookEventIgnored, event.Action)
+}
+
+func (g *GitHubWebhookServiceImpl) validSignature(signature string, payload []byte) bool {
+	if g.cfg.WebhookSecret == "" {
+		return false
+	}
+
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(g.cfg.WebhookSecret))
+	mac.Write(payload)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+)
+
+type gitLabBoolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+type gitLabMergeRequestEvent struct {
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *gitLabBoolChange `json:"draft"`
+		WorkInProgress *gitLabBoolChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+type GitLabWebhookServiceImpl struct {
+	logger    *slog.Logger
+	prService PullRequestService
+	cfg       config.GitLabConfig
+}
+
+func NewGitLabWebhookService(logger *slog.Logger, prService PullRequestService, cfg config.GitLabConfig) GitLabWebhookService {
+	return &GitLabWebhookServiceImpl{
+		logger:    logger,
+		prService: prService,
+		cfg:       cfg,
+	}
+}
+
+func (g *GitLabWebhookServiceImpl) HandleEvent(ctx context.Context, eventType string, token string, payload []byte) (*models.PullRequest, error) {
+	if !g.validToken(token) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	if eventType != "Merge Request Hook" {
+		return nil, fmt.Errorf("%w: event %s", ErrWebhookEventIgnored, eventType)
+	}
+
+	var event gitLabMergeRequestEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookPayload, err.Error())
+	}
+	if event.Project.PathWithNamespace == "" || event.ObjectAttributes.IID == 0 {
+		return nil, fmt.Errorf("%w: project and iid are required", ErrInvalidWebhookPayload)
+	}
+
+	prID := fmt.Sprintf("%s!%d", event.Project.PathWithNamespace, event.ObjectAttributes.IID)
+
+	g.logger.Info("GitLab merge request event",
+		slog.String("action", event.ObjectAttributes.Action),
+		slog.String("pull_request_id", prID),
+	)
+
+	switch event.ObjectAttributes.Action {
+	case "open":
+		authorID, ok := g.cfg.UserIDForUsername(event.User.Username)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownWebhookUser, event.User.Username)
+		}
+
+		draft := event.ObjectAttributes.Draft || event.ObjectAttributes.WorkInProgress
+		return g.prService.CreatePullRequest(ctx, &api.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: event.ObjectAttributes.Title,
+			AuthorId:        authorID,
+			Draft:           &draft,
+		})
+	case "merge":
+		return g.prService.RecordExternalMerge(ctx, &api.PostPullRequestMergeJSONRequestBody{PullRequestId: prID})
+	case "close":
+		return g.prService.ClosePullRequest(ctx, &api.PostPullRequestCloseJSONRequestBody{PullRequestId: prID})
+	case "reopen":
+		return g.prService.ReopenPullRequest(ctx, &api.PostPullRequestReopenJSONRequestBody{PullRequestId: prID})
+	case "update":
+		change := event.Changes.Draft
+		if change == nil {
+			change = event.Changes.WorkInProgress
+		}
+		if change == nil || change.Previous == change.Current {
+			break
+		}
+
+		if change.Current {
+			return g.prService.MarkPullRequestDraft(ctx, &api.PostPullRequestDraftJSONRequestBody{PullRequestId: prID})
+		}
+		return g.prService.MarkPullRequestReady(ctx, &api.PostPullRequestReadyJSONRequestBody{PullRequestId: prID})
+	}
+
+	return nil, fmt.Errorf("%w: action %s", ErrWebhookEventIgnored, event.ObjectAttributes.Action)
+}
+
+func (g *GitLabWebhookServiceImpl) validToken(token string) bool {
+	if g.cfg.WebhookToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(g.cfg.WebhookToken)) == 1
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+)
+
+const SystemActor = "system"
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actorID string) context.Context {
+	if actorID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, actorKey{}, actorID)
+}
+
+func actorFromContext(ctx context.Context) string {
+	if actorID, ok := ctx.Value(actorKey{}).(string); ok {
+		return actorID
+	}
+	return SystemActor
+}
+
+func historyEntry(ctx context.Context, action entity.HistoryAction, before, after *entity.PullRequest, reason string) entity.PullRequestHistoryEntry {
+	entry := entity.PullRequestHistoryEntry{
+		Action:  action,
+		ActorID: actorFromContext(ctx),
+		Before:  snapshotOf(before),
+		After:   snapshotOf(after),
+		Reason:  reason,
+	}
+
+	if after != nil {
+		entry.PullRequestID = after.PullRequestID
+	} else if before != nil {
+		entry.PullRequestID = before.PullRequestID
+	}
+
+	return entry
+}
+
+func handOverHistoryEntry(ctx context.Context, pr *entity.PullRequest, oldReviewerID string, newReviewerID *string, reason string) entity.PullRequestHistoryEntry {
+	after := *pr
+	after.AssignedReviewers = make([]string, 0, len(pr.AssignedReviewers))
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		switch {
+		case reviewerID != oldReviewerID:
+			after.AssignedReviewers = append(after.AssignedReviewers, reviewerID)
+		case newReviewerID != nil:
+			after.AssignedReviewers = append(after.AssignedReviewers, *newReviewerID)
+		}
+	}
+
+	action := entity.HistoryReviewerReassigned
+	if newReviewerID == nil {
+		action = entity.HistoryReviewerRemoved
+	}
+
+	return historyEntry(ctx, action, pr, &after, reason)
+}
+
+func snapshotOf(pr *entity.PullRequest) *entity.PullRequestSnapshot {
+	if pr == nil {
+		return nil
+	}
+
+	reviewers := []string{}
+	if pr.AssignedReviewers != nil {
+		reviewers = slices.Clone(pr.AssignedReviewers)
+	}
+
+	return &entity.PullRequestSnapshot{
+		Status:            pr.Status,
+		AssignedReviewers: reviewers,
+	}
+}
+
+func statusChangeReason(status entity.PullRequestStatus) string {
+	switch status {
+	case entity.StatusOpen:
+		return "pull request opened for review"
+	case entity.StatusDraft:
+		return "pull request marked as draft"
+	case entity.StatusClosed:
+		return "pull request closed"
+	case entity.StatusMerged:
+		return "pull request merged"
+	}
+	return "status changed to " + string(status)
+}
+
+func reasonOrDefault(reason *string, fallback string) string {
+	if reason == nil || *reason == "" {
+		return fallback
+	}
+	return *reason
+}
+
+func toPullRequestHistoryModel(entry *entity.PullRequestHistoryEntry) models.PullRequestHistory {
+	return models.PullRequestHistory{
+		EventId:   entry.ID,
+		Action:    api.PullRequestHistoryEntryAction(entry.Action),
+		ActorId:   entry.ActorID,
+		Before:    toPullRequestSnapshotModel(entry.Before),
+		After:     toPullRequestSnapshotModel(entry.After),
+		Reason:    entry.Reason,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func toPullRequestSnapshotModel(snapshot *entity.PullRequestSnapshot) *models.PullRequestSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+
+	return &models.PullRequestSnapshot{
+		Status:            api.PullRequestSnapshotStatus(snapshot.Status),
+		AssignedReviewers: snapshot.AssignedReviewers,
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+)
+
+// ErrDeliveryRejected marks a delivery the receiver refused with a status
+// that retrying will not fix.
+var ErrDeliveryRejected = errors.New("delivery rejected")
+
+type HTTPDeliverer struct {
+	logger *slog.Logger
+	client *http.Client
+}
+
+func NewHTTPDeliverer(logger *slog.Logger, cfg config.WebhooksConfig) *HTTPDeliverer {
+	return &HTTPDeliverer{
+		logger: logger,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Deliver makes a single POST and returns once the receiver has answered.
+// Only a 2xx response counts as delivered; retries are left to the outbox.
+func (h *HTTPDeliverer) Deliver(ctx context.Context, target string, headers map[string]string, payload []byte, attrs ...any) error {
+	started := time.Now()
+	status, err := h.send(ctx, target, headers, payload)
+
+	attemptAttrs := append(attrs[:len(attrs):len(attrs)],
+		slog.Int("status", status),
+		slog.Duration("duration", time.Since(started)),
+	)
+
+	if err != nil {
+		h.logger.Warn("Webhook delivery attempt failed", append(attemptAttrs, slog.String("error", err.Error()))...)
+		return err
+	}
+
+	h.logger.Info("Webhook delivered", attemptAttrs...)
+	return nil
+}
+
+func (h *HTTPDeliverer) send(ctx context.Context, target string, headers map[string]string, payload []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrDeliveryRejected, err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "PR-Service-Webhooks")
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return response.StatusCode, nil
+	}
+
+	retryable := response.StatusCode >= 500 ||
+		response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests
+	if !retryable {
+		return response.StatusCode, fmt.Errorf("%w: unexpected status %d", ErrDeliveryRejected, response.StatusCode)
+	}
+
+	return response.StatusCode, fmt.Errorf("unexpected status %d", response.StatusCode)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/oooooorg/PR-Service/internal/entity"
 	api "github.com/oooooorg/PR-Service/internal/gen"
 	"github.com/oooooorg/PR-Service/internal/models"
 )
@@ -10,15 +11,64 @@ import (
 type PullRequestService interface {
 	CreatePullRequest(ctx context.Context, req *api.PostPullRequestCreateJSONRequestBody) (*models.PullRequest, error)
 	MergePullRequest(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error)
+	RecordExternalMerge(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error)
+	ClosePullRequest(ctx context.Context, req *api.PostPullRequestCloseJSONRequestBody) (*models.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, req *api.PostPullRequestReopenJSONRequestBody) (*models.PullRequest, error)
+	MarkPullRequestReady(ctx context.Context, req *api.PostPullRequestReadyJSONRequestBody) (*models.PullRequest, error)
+	MarkPullRequestDraft(ctx context.Context, req *api.PostPullRequestDraftJSONRequestBody) (*models.PullRequest, error)
+	ReviewPullRequest(ctx context.Context, req *api.PostPullRequestReviewJSONRequestBody) (*models.PullRequest, error)
 	ReassignReviewer(ctx context.Context, req *api.PostPullRequestReassignJSONRequestBody) (*models.PullRequest, string, error)
+	GetPullRequestHistory(ctx context.Context, req *api.GetPullRequestHistoryParams) ([]models.PullRequestHistory, error)
 	GetUserReviewRequests(ctx context.Context, req *api.GetUsersGetReviewParams) ([]*models.PullRequestShort, error)
 }
 
 type TeamService interface {
 	CreateTeam(ctx context.Context, team *api.Team) (*models.Team, error)
 	GetTeam(ctx context.Context, req *api.GetTeamGetParams) (*models.Team, error)
+	UpdateTeam(ctx context.Context, req *api.PostTeamUpdateJSONRequestBody) (*models.Team, error)
+	AddTeamMembers(ctx context.Context, req *api.PostTeamAddMembersJSONRequestBody) (*models.Team, error)
+	RemoveTeamMember(ctx context.Context, req *api.PostTeamRemoveMemberJSONRequestBody) (*models.Team, error)
+	DeleteTeam(ctx context.Context, req *api.PostTeamDeleteJSONRequestBody) ([]string, []models.ReviewReassignment, error)
+	GetOwnershipRules(ctx context.Context, req *api.GetTeamGetOwnershipRulesParams) ([]models.OwnershipRule, error)
+	SetOwnershipRules(ctx context.Context, req *api.PostTeamSetOwnershipRulesJSONRequestBody) ([]models.OwnershipRule, error)
+	ImportCodeowners(ctx context.Context, req *api.PostTeamImportCodeownersJSONRequestBody) ([]models.OwnershipRule, []string, error)
 }
 
 type UserService interface {
-	SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, error)
+	SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, []models.ReviewReassignment, error)
+	SetMaxOpenReviews(ctx context.Context, req *api.PostUsersSetMaxOpenReviewsJSONRequestBody) (*models.User, error)
+	BulkDeactivate(ctx context.Context, req *api.PostUsersBulkDeactivateJSONRequestBody) ([]*models.User, []models.ReviewReassignment, error)
+	MoveUserToTeam(ctx context.Context, req *api.PostUsersMoveTeamJSONRequestBody) (*models.User, []models.ReviewReassignment, error)
+	AddAbsence(ctx context.Context, req *api.PostUsersAddAbsenceJSONRequestBody) (*models.Absence, error)
+	GetAbsences(ctx context.Context, req *api.GetUsersGetAbsencesParams) ([]*models.Absence, error)
+	UpdateAbsence(ctx context.Context, req *api.PostUsersUpdateAbsenceJSONRequestBody) (*models.Absence, error)
+	DeleteAbsence(ctx context.Context, req *api.PostUsersDeleteAbsenceJSONRequestBody) error
+	HandOverStartedAbsences(ctx context.Context) ([]models.ReviewReassignment, error)
+}
+
+type GitHubWebhookService interface {
+	HandleEvent(ctx context.Context, eventType string, signature string, payload []byte) (*models.PullRequest, error)
+}
+
+type GitLabWebhookService interface {
+	HandleEvent(ctx context.Context, eventType string, token string, payload []byte) (*models.PullRequest, error)
+}
+
+type WebhookSubscriptionService interface {
+	AddSubscription(ctx context.Context, req *api.PostWebhooksAddSubscriptionJSONRequestBody) (*models.WebhookSubscription, error)
+	GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, req *api.PostWebhooksDeleteSubscriptionJSONRequestBody) error
+}
+
+type EventPublisher interface {
+	Deliveries(ctx context.Context, event entity.Event) ([]EventDelivery, error)
+}
+
+type ReviewReminderService interface {
+	ProcessSilentReviews(ctx context.Context) (int, int, error)
+}
+
+type StatsService interface {
+	GetReviewerStats(ctx context.Context, req *api.GetStatsReviewersParams) ([]models.ReviewerStats, error)
+	GetTeamStats(ctx context.Context, req *api.GetStatsTeamsParams) ([]models.TeamWeekStats, error)
 }
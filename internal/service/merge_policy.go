@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+var ErrPullRequestMergeBlocked = errors.New("pull request merge blocked")
+
+type MergeBlockedError struct {
+	Conditions []string
+}
+
+func (e *MergeBlockedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPullRequestMergeBlocked, strings.Join(e.Conditions, "; "))
+}
+
+func (e *MergeBlockedError) Unwrap() error {
+	return ErrPullRequestMergeBlocked
+}
+
+func unmetMergeConditions(team *entity.Team, pr *entity.PullRequest) []string {
+	conditions := []string{}
+
+	approvals := 0
+	for _, review := range pr.Reviews {
+		isAuthor := review.ReviewerID == pr.AuthorID
+		if !isAuthor && !slices.Contains(pr.AssignedReviewers, review.ReviewerID) {
+			continue
+		}
+
+		switch review.Verdict {
+		case entity.VerdictApprove:
+			if isAuthor && !team.AllowSelfApproval {
+				continue
+			}
+			approvals++
+		case entity.VerdictRequestChanges:
+			if team.BlockOnChangesRequested && !isAuthor {
+				conditions = append(conditions, fmt.Sprintf("changes requested by %s", review.ReviewerID))
+			}
+		}
+	}
+
+	if approvals < team.RequiredApprovals {
+		conditions = append(conditions, fmt.Sprintf("%d of %d required approvals", approvals, team.RequiredApprovals))
+	}
+
+	return conditions
+}
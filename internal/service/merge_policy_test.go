@@ -0,0 +1,95 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func TestUnmetMergeConditions(t *testing.T) {
+	review := func(reviewerID string, verdict entity.ReviewVerdict) *entity.Review {
+		return &entity.Review{PullRequestID: "pr-1", ReviewerID: reviewerID, Verdict: verdict}
+	}
+
+	tests := []struct {
+		name    string
+		team    entity.Team
+		reviews []*entity.Review
+		want    []string
+	}{
+		{
+			name: "no policy",
+			team: entity.Team{},
+			want: []string{},
+		},
+		{
+			name:    "enough approvals from assigned reviewers",
+			team:    entity.Team{RequiredApprovals: 2},
+			reviews: []*entity.Review{review("u2", entity.VerdictApprove), review("u3", entity.VerdictApprove)},
+			want:    []string{},
+		},
+		{
+			name:    "missing approvals",
+			team:    entity.Team{RequiredApprovals: 2},
+			reviews: []*entity.Review{review("u2", entity.VerdictApprove), review("u3", entity.VerdictComment)},
+			want:    []string{"1 of 2 required approvals"},
+		},
+		{
+			name:    "approval from unassigned user is ignored",
+			team:    entity.Team{RequiredApprovals: 1},
+			reviews: []*entity.Review{review("u9", entity.VerdictApprove)},
+			want:    []string{"0 of 1 required approvals"},
+		},
+		{
+			name:    "self approval not counted by default",
+			team:    entity.Team{RequiredApprovals: 2},
+			reviews: []*entity.Review{review("u1", entity.VerdictApprove), review("u2", entity.VerdictApprove)},
+			want:    []string{"1 of 2 required approvals"},
+		},
+		{
+			name:    "self approval counted when allowed",
+			team:    entity.Team{RequiredApprovals: 2, AllowSelfApproval: true},
+			reviews: []*entity.Review{review("u1", entity.VerdictApprove), review("u2", entity.VerdictApprove)},
+			want:    []string{},
+		},
+		{
+			name:    "changes requested blocks when enabled",
+			team:    entity.Team{BlockOnChangesRequested: true},
+			reviews: []*entity.Review{review("u3", entity.VerdictRequestChanges)},
+			want:    []string{"changes requested by u3"},
+		},
+		{
+			name:    "changes requested ignored when disabled",
+			team:    entity.Team{},
+			reviews: []*entity.Review{review("u3", entity.VerdictRequestChanges)},
+			want:    []string{},
+		},
+		{
+			name:    "changes requested by author does not block",
+			team:    entity.Team{BlockOnChangesRequested: true},
+			reviews: []*entity.Review{review("u1", entity.VerdictRequestChanges)},
+			want:    []string{},
+		},
+		{
+			name:    "changes requested by unassigned user does not block",
+			team:    entity.Team{BlockOnChangesRequested: true},
+			reviews: []*entity.Review{review("u9", entity.VerdictRequestChanges)},
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &entity.PullRequest{
+				PullRequestID:     "pr-1",
+				AuthorID:          "u1",
+				AssignedReviewers: []string{"u2", "u3"},
+				Reviews:           tt.reviews,
+			}
+
+			assert.Equal(t, tt.want, unmetMergeConditions(&tt.team, pr))
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+// EventDelivery is one HTTP request an outbox event fans out to. Target
+// identifies the receiver across retries, so a receiver that has already
+// accepted the event is not sent it again.
+type EventDelivery struct {
+	Target  string
+	URL     string
+	Headers map[string]string
+	Payload []byte
+	Attrs   []any
+}
+
+type OutboxDispatcher struct {
+	logger     *slog.Logger
+	outboxRepo repository.OutboxRepository
+	deliverer  *HTTPDeliverer
+	publishers []EventPublisher
+	cfg        config.OutboxConfig
+	retry      config.WebhooksConfig
+}
+
+func NewOutboxDispatcher(
+	logger *slog.Logger,
+	outboxRepo repository.OutboxRepository,
+	deliverer *HTTPDeliverer,
+	cfg config.OutboxConfig,
+	retry config.WebhooksConfig,
+	publishers ...EventPublisher,
+) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		logger:     logger,
+		outboxRepo: outboxRepo,
+		deliverer:  deliverer,
+		publishers: publishers,
+		cfg:        cfg,
+		retry:      retry,
+	}
+}
+
+func (o *OutboxDispatcher) BatchSize() int {
+	return o.cfg.BatchSize
+}
+
+// publishResult is the outcome of publishing one claimed outbox entry.
+type publishResult struct {
+	entry     *entity.OutboxEntry
+	targets   []string
+	retryable bool
+	err       error
+}
+
+// DispatchPending claims a batch of events, delivers them outside any
+// transaction and then records the outcome. Claimed rows are leased for
+// outbox.lease, so a dispatcher that dies mid-batch only delays them.
+func (o *OutboxDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	entries, err := o.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	results := make([]publishResult, 0, len(entries))
+	for _, entry := range entries {
+		targets, retryable, publishErr := o.publish(ctx, entry)
+		results = append(results, publishResult{entry: entry, targets: targets, retryable: retryable, err: publishErr})
+	}
+
+	return o.record(ctx, results)
+}
+
+func (o *OutboxDispatcher) claim(ctx context.Context) ([]*entity.OutboxEntry, error) {
+	tx, err := o.outboxRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	entries, err := o.outboxRepo.ClaimPendingEvents(ctx, tx, o.cfg.BatchSize, o.cfg.Lease)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (o *OutboxDispatcher) record(ctx context.Context, results []publishResult) (int, error) {
+	tx, err := o.outboxRepo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	delivered := []int64{}
+	for _, result := range results {
+		entry := result.entry
+		if result.err == nil {
+			delivered = append(delivered, entry.Event.ID)
+			continue
+		}
+
+		attempts := entry.Attempts + 1
+		attrs := []any{
+			slog.Int64("event_id", entry.Event.ID),
+			slog.String("event", string(entry.Event.Type)),
+			slog.Int("attempt", attempts),
+			slog.String("error", result.err.Error()),
+		}
+
+		if !result.retryable || attempts >= o.retry.MaxAttempts {
+			o.logger.Error("Outbox event abandoned", attrs...)
+			if err = o.outboxRepo.MarkEventDead(ctx, tx, entry.Event.ID, result.targets, result.err.Error()); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		o.logger.Warn("Outbox event publish failed", attrs...)
+		if err = o.outboxRepo.MarkEventFailed(ctx, tx, entry.Event.ID, result.targets, result.err.Error(), o.retry.BackoffAfterAttempt(attempts)); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(delivered) > 0 {
+		if err = o.outboxRepo.MarkEventsDelivered(ctx, tx, delivered); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(delivered), nil
+}
+
+// publish sends the event to every target that has not accepted it yet and
+// returns the updated list of targets that have. The failure is retryable
+// unless every failed target rejected the event outright.
+func (o *OutboxDispatcher) publish(ctx context.Context, entry *entity.OutboxEntry) ([]string, bool, error) {
+	targets := slices.Clone(entry.DeliveredTargets)
+
+	var errs []error
+	retryable := false
+
+	for _, publisher := range o.publishers {
+		deliveries, err := publisher.Deliveries(ctx, entry.Event)
+		if err != nil {
+			errs = append(errs, err)
+			retryable = true
+			continue
+		}
+
+		for _, delivery := range deliveries {
+			if slices.Contains(targets, delivery.Target) {
+				continue
+			}
+
+			attrs := append(delivery.Attrs[:len(delivery.Attrs):len(delivery.Attrs)], slog.Int("attempt", entry.Attempts+1))
+			if err := o.deliverer.Deliver(ctx, delivery.URL, delivery.Headers, delivery.Payload, attrs...); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", delivery.Target, err))
+				if !errors.Is(err, ErrDeliveryRejected) {
+					retryable = true
+				}
+				continue
+			}
+
+			targets = append(targets, delivery.Target)
+		}
+	}
+
+	return targets, retryable, errors.Join(errs...)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type receiver struct {
+	server *httptest.Server
+	status atomic.Int32
+	hits   atomic.Int32
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{}
+	r.status.Store(int32(status))
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r.hits.Add(1)
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+type staticPublisher struct {
+	targets map[string]*receiver
+}
+
+func (p staticPublisher) Deliveries(_ context.Context, _ entity.Event) ([]EventDelivery, error) {
+	var deliveries []EventDelivery
+	for _, target := range []string{"a", "b"} {
+		if r, ok := p.targets[target]; ok {
+			deliveries = append(deliveries, EventDelivery{Target: target, URL: r.server.URL})
+		}
+	}
+	return deliveries, nil
+}
+
+func newTestDispatcher(outboxRepo *fakeOutboxRepo, targets map[string]*receiver) *OutboxDispatcher {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	retry := config.WebhooksConfig{Timeout: time.Second, MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+
+	return NewOutboxDispatcher(logger, outboxRepo, NewHTTPDeliverer(logger, retry), config.OutboxConfig{BatchSize: 10, Lease: time.Minute}, retry, staticPublisher{targets: targets})
+}
+
+func pendingEntry(attempts int, deliveredTargets ...string) *entity.OutboxEntry {
+	return &entity.OutboxEntry{
+		Event:            entity.Event{ID: 1, Type: entity.EventReviewerAssigned},
+		Attempts:         attempts,
+		DeliveredTargets: deliveredTargets,
+	}
+}
+
+func TestOutboxDispatcher_DeliveredOnlyAfterEveryTargetAccepts(t *testing.T) {
+	a, b := newReceiver(t, http.StatusOK), newReceiver(t, http.StatusNoContent)
+	outboxRepo := newFakeOutboxRepo(pendingEntry(0))
+
+	delivered, err := newTestDispatcher(outboxRepo, map[string]*receiver{"a": a, "b": b}).DispatchPending(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []int64{1}, outboxRepo.delivered)
+	assert.Empty(t, outboxRepo.failed)
+	assert.EqualValues(t, 1, a.hits.Load())
+	assert.EqualValues(t, 1, b.hits.Load())
+}
+
+func TestOutboxDispatcher_LeasesClaimedEventsAndRecordsInSeparateTx(t *testing.T) {
+	a := newReceiver(t, http.StatusOK)
+	outboxRepo := newFakeOutboxRepo(pendingEntry(0))
+
+	_, err := newTestDispatcher(outboxRepo, map[string]*receiver{"a": a}).DispatchPending(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Minute, outboxRepo.lease)
+	assert.Equal(t, 2, outboxRepo.txs)
+}
+
+func TestOutboxDispatcher_NothingPendingSkipsRecording(t *testing.T) {
+	outboxRepo := newFakeOutboxRepo()
+
+	delivered, err := newTestDispatcher(outboxRepo, nil).DispatchPending(context.Background())
+	require.NoError(t, err)
+
+	assert.Zero(t, delivered)
+	assert.Equal(t, 1, outboxRepo.txs)
+}
+
+func TestOutboxDispatcher_RetriesOnlyFailedTargets(t *testing.T) {
+	a, b := newReceiver(t, http.StatusOK), newReceiver(t, http.StatusServiceUnavailable)
+	targets := map[string]*receiver{"a": a, "b": b}
+
+	outboxRepo := newFakeOutboxRepo(pendingEntry(0))
+	delivered, err := newTestDispatcher(outboxRepo, targets).DispatchPending(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, delivered)
+	assert.Empty(t, outboxRepo.delivered)
+	require.Contains(t, outboxRepo.failed, int64(1))
+	assert.Equal(t, []string{"a"}, outboxRepo.failed[1].deliveredTargets)
+	assert.Equal(t, time.Second, outboxRepo.failed[1].retryAfter)
+
+	b.status.Store(http.StatusOK)
+	outboxRepo = newFakeOutboxRepo(pendingEntry(1, outboxRepo.failed[1].deliveredTargets...))
+	delivered, err = newTestDispatcher(outboxRepo, targets).DispatchPending(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, delivered)
+	assert.EqualValues(t, 1, a.hits.Load())
+	assert.EqualValues(t, 2, b.hits.Load())
+}
+
+func TestOutboxDispatcher_DeadLetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		attempts int
+	}{
+		{"attempts exhausted", http.StatusInternalServerError, 2},
+		{"rejected by receiver", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := newReceiver(t, http.StatusOK), newReceiver(t, tt.status)
+			outboxRepo := newFakeOutboxRepo(pendingEntry(tt.attempts))
+
+			delivered, err := newTestDispatcher(outboxRepo, map[string]*receiver{"a": a, "b": b}).DispatchPending(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, 0, delivered)
+			assert.Empty(t, outboxRepo.failed)
+			require.Contains(t, outboxRepo.dead, int64(1))
+			assert.Equal(t, []string{"a"}, outboxRepo.dead[1].deliveredTargets)
+		})
+	}
+}
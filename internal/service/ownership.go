@@ -0,0 +1,98 @@
+package service
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func ownersForFiles(rules []*entity.OwnershipRule, files []string) []string {
+	var owners []string
+
+	for _, file := range files {
+		var matched *entity.OwnershipRule
+		for _, rule := range rules {
+			if matchOwnershipPattern(rule.Pattern, file) {
+				matched = rule
+			}
+		}
+		if matched == nil {
+			continue
+		}
+
+		for _, owner := range matched.Owners {
+			if !slices.Contains(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+	}
+
+	return owners
+}
+
+func matchOwnershipPattern(pattern, file string) bool {
+	file = strings.Trim(file, "/")
+	if file == "" {
+		return false
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if pattern == "" {
+		return false
+	}
+
+	patternParts := strings.Split(pattern, "/")
+	if !anchored {
+		patternParts = append([]string{"**"}, patternParts...)
+	}
+
+	// "docs/" and "docs" own everything below the directory, "docs/*" only its direct children.
+	last := patternParts[len(patternParts)-1]
+	matchesDirectories := dirOnly || !strings.ContainsAny(last, "*?[")
+
+	fileParts := strings.Split(file, "/")
+	for end := len(fileParts); end > 0; end-- {
+		if end < len(fileParts) && !matchesDirectories {
+			break
+		}
+		if end == len(fileParts) && dirOnly {
+			continue
+		}
+		if matchPathParts(patternParts, fileParts[:end]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPathParts(pattern, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if matchPathParts(pattern[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(parts) == 0 {
+		return false
+	}
+
+	ok, err := path.Match(pattern[0], parts[0])
+	if err != nil || !ok {
+		return false
+	}
+
+	return matchPathParts(pattern[1:], parts[1:])
+}
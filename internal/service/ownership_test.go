@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func TestMatchOwnershipPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		file    string
+		want    bool
+	}{
+		{"floating extension at root", "*.go", "main.go", true},
+		{"floating extension nested", "*.go", "cmd/app/main.go", true},
+		{"floating extension mismatch", "*.go", "main.js", false},
+		{"floating name matches nested directory", "docs", "src/docs/guide.md", true},
+		{"anchored path owns subtree", "/build/logs", "build/logs/out/today.log", true},
+		{"anchored path not nested", "/build/logs", "src/build/logs/today.log", false},
+		{"pattern with slash is anchored", "build/logs", "src/build/logs/today.log", false},
+		{"single star matches direct child", "docs/*", "docs/guide.md", true},
+		{"single star skips grandchildren", "docs/*", "docs/api/guide.md", false},
+		{"leading double star", "**/logs", "logs/today.log", true},
+		{"leading double star nested", "**/logs", "a/b/logs/today.log", true},
+		{"inner double star zero directories", "docs/**/*.md", "docs/guide.md", true},
+		{"inner double star many directories", "docs/**/*.md", "docs/a/b/guide.md", true},
+		{"inner double star wrong extension", "docs/**/*.md", "docs/a/guide.txt", false},
+		{"dir-only owns subtree", "apps/", "apps/web/index.ts", true},
+		{"dir-only is floating", "apps/", "services/apps/index.ts", true},
+		{"dir-only skips file with same name", "apps/", "apps", false},
+		{"leading and trailing slashes in file", "/docs/", "/docs/guide.md/", true},
+		{"empty pattern", "/", "main.go", false},
+		{"empty file", "*", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchOwnershipPattern(tt.pattern, tt.file))
+		})
+	}
+}
+
+func TestOwnersForFiles(t *testing.T) {
+	rules := []*entity.OwnershipRule{
+		{Pattern: "*", Owners: []string{"u1"}},
+		{Pattern: "*.go", Owners: []string{"u2"}},
+		{Pattern: "/docs/", Owners: []string{"u3", "u4"}},
+	}
+
+	tests := []struct {
+		name  string
+		rules []*entity.OwnershipRule
+		files []string
+		want  []string
+	}{
+		{"catch-all rule", rules, []string{"README.md"}, []string{"u1"}},
+		{"later rule wins", rules, []string{"cmd/main.go"}, []string{"u2"}},
+		{"last matching rule wins over earlier ones", rules, []string{"docs/gen.go"}, []string{"u3", "u4"}},
+		{"owners merged in file order", rules, []string{"main.go", "README.md", "docs/guide.md"}, []string{"u2", "u1", "u3", "u4"}},
+		{"owners deduplicated", rules, []string{"a.go", "b.go"}, []string{"u2"}},
+		{"unmatched file", rules[1:], []string{"README.md"}, nil},
+		{"no files", rules, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ownersForFiles(tt.rules, tt.files))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+)
+
+type draftTestService struct {
+	*PullRequestServiceImpl
+	prRepo      *fakePullRequestRepo
+	outboxRepo  *fakeOutboxRepo
+	historyRepo *fakeHistoryRepo
+}
+
+func newDraftTestService(pr *entity.PullRequest) *draftTestService {
+	userRepo := newFakeUserRepo(
+		&entity.User{UserID: "u1", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u2", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u3", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u4", TeamName: "backend", IsActive: true},
+	)
+	teamRepo := newFakeTeamRepo(&entity.Team{TeamName: "backend", RequiredReviewers: 2})
+	prRepo := newFakePullRequestRepo(pr)
+	outboxRepo := newFakeOutboxRepo()
+	historyRepo := &fakeHistoryRepo{}
+	selectors := NewReviewerSelectors(config.ReviewersConfig{Strategy: config.ReviewerStrategyRoundRobin}, prRepo, teamRepo)
+
+	return &draftTestService{
+		PullRequestServiceImpl: &PullRequestServiceImpl{
+			prRepo:      prRepo,
+			userRepo:    userRepo,
+			teamRepo:    teamRepo,
+			reviewRepo:  &fakeReviewRepo{},
+			assigner:    NewReviewerAssigner(userRepo, prRepo, &fakeAbsenceRepo{}, selectors),
+			outboxRepo:  outboxRepo,
+			historyRepo: historyRepo,
+		},
+		prRepo:      prRepo,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+func TestMarkPullRequestDraft_ClearsReviewers(t *testing.T) {
+	ctx := context.Background()
+	svc := newDraftTestService(&entity.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		AssignedReviewers: []string{"u3", "u4"},
+		Status:            entity.StatusOpen,
+	})
+
+	draft, err := svc.MarkPullRequestDraft(ctx, &api.PostPullRequestDraftJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "DRAFT", string(draft.Status))
+	assert.Empty(t, draft.AssignedReviewers)
+	assert.Empty(t, svc.prRepo.prs["pr-1"].AssignedReviewers)
+
+	require.Len(t, svc.outboxRepo.added, 2)
+	for i, reviewerID := range []string{"u3", "u4"} {
+		assert.Equal(t, entity.EventReviewerUnassigned, svc.outboxRepo.added[i].Type)
+		assert.Equal(t, reviewerID, svc.outboxRepo.added[i].ReviewerID)
+	}
+
+	require.Len(t, svc.historyRepo.entries, 1)
+	entry := svc.historyRepo.entries[0]
+	assert.Equal(t, entity.HistoryStatusChanged, entry.Action)
+	assert.Equal(t, []string{"u3", "u4"}, entry.Before.AssignedReviewers)
+	assert.Empty(t, entry.After.AssignedReviewers)
+}
+
+func TestMarkPullRequestReady_PicksReviewersAfterDraft(t *testing.T) {
+	ctx := context.Background()
+	svc := newDraftTestService(&entity.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		AssignedReviewers: []string{"u3", "u4"},
+		Status:            entity.StatusOpen,
+	})
+
+	_, err := svc.MarkPullRequestDraft(ctx, &api.PostPullRequestDraftJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	svc.outboxRepo.added = nil
+
+	open, err := svc.MarkPullRequestReady(ctx, &api.PostPullRequestReadyJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", string(open.Status))
+	assert.Equal(t, []string{"u2", "u3"}, open.AssignedReviewers)
+	require.NotNil(t, open.ReviewerTeam)
+	assert.Equal(t, "backend", *open.ReviewerTeam)
+	assert.Equal(t, []string{"u2", "u3"}, svc.prRepo.prs["pr-1"].AssignedReviewers)
+
+	require.Len(t, svc.outboxRepo.added, 2)
+	for _, event := range svc.outboxRepo.added {
+		assert.Equal(t, entity.EventReviewerAssigned, event.Type)
+	}
+	require.Len(t, svc.historyRepo.entries, 2)
+}
+
+func TestMarkPullRequestReady_CreatedAsDraftGetsReviewers(t *testing.T) {
+	ctx := context.Background()
+	svc := newDraftTestService(&entity.PullRequest{
+		PullRequestID: "pr-1",
+		AuthorID:      "u1",
+		Status:        entity.StatusDraft,
+	})
+
+	open, err := svc.MarkPullRequestReady(ctx, &api.PostPullRequestReadyJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", string(open.Status))
+	assert.Equal(t, []string{"u2", "u3"}, open.AssignedReviewers)
+	assert.Equal(t, []string{"u2", "u3"}, svc.prRepo.prs["pr-1"].AssignedReviewers)
+}
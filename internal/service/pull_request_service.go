@@ -4,9 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
-	"math/rand"
-	"time"
+	"slices"
 
 	"github.com/oooooorg/PR-Service/internal/entity"
 	api "github.com/oooooorg/PR-Service/internal/gen"
@@ -18,12 +18,19 @@ var ErrPullRequestExists = errors.New("pull request already exists")
 var ErrPullRequestNotAsigned = errors.New("pull request not_asigned")
 var ErrPullRequestNoCandidate = errors.New("pull request no_candidate")
 var ErrPullRequestMerged = errors.New("pull request already merged")
+var ErrPullRequestClosed = errors.New("pull request closed")
+var ErrPullRequestInvalidTransition = errors.New("invalid pull request status transition")
+var ErrInvalidVerdict = errors.New("unknown review verdict")
 
 type PullRequestServiceImpl struct {
-	logger   *slog.Logger
-	prRepo   repository.PullRequestRepository
-	userRepo repository.UserRepository
-	teamRepo repository.TeamRepository
+	logger      *slog.Logger
+	prRepo      repository.PullRequestRepository
+	userRepo    repository.UserRepository
+	teamRepo    repository.TeamRepository
+	reviewRepo  repository.ReviewRepository
+	assigner    *ReviewerAssigner
+	outboxRepo  repository.OutboxRepository
+	historyRepo repository.PullRequestHistoryRepository
 }
 
 func NewPullRequestService(
@@ -31,76 +38,71 @@ func NewPullRequestService(
 	prRepo repository.PullRequestRepository,
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
+	reviewRepo repository.ReviewRepository,
+	assigner *ReviewerAssigner,
+	outboxRepo repository.OutboxRepository,
+	historyRepo repository.PullRequestHistoryRepository,
 ) PullRequestService {
 	return &PullRequestServiceImpl{
-		logger:   logger,
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
+		logger:      logger,
+		prRepo:      prRepo,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		reviewRepo:  reviewRepo,
+		assigner:    assigner,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
 	}
 }
 
-func (p *PullRequestServiceImpl) GetUsersForPR(ctx context.Context, authorID string) ([]string, error) {
+func (p *PullRequestServiceImpl) GetUsersForPR(ctx context.Context, tx *sql.Tx, authorID string, changedFiles []string) ([]string, string, error) {
 	if authorID == "" {
-		return nil, errors.New("authorID is required")
+		return nil, "", errors.New("authorID is required")
 	}
 
-	tx, err := p.prRepo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
 	author, err := p.userRepo.GetUserByID(ctx, tx, authorID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	users, err := p.userRepo.GetUsersByTeam(ctx, tx, author.TeamName)
+	team, err := p.teamRepo.GetTeamByName(ctx, tx, author.TeamName)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	var candidates []*entity.User
-	for _, u := range users {
-		if !u.IsActive {
-			continue
-		}
-		if u.UserID == authorID {
-			continue
-		}
-		candidates = append(candidates, u)
+	if len(changedFiles) == 0 {
+		return p.assigner.Pick(ctx, tx, reviewerSourceTeams(team), []string{authorID}, team.RequiredReviewers)
 	}
 
-	if len(candidates) == 0 {
-		return []string{}, nil
+	rules, err := p.teamRepo.GetOwnershipRules(ctx, tx, team.TeamName)
+	if err != nil {
+		return nil, "", err
 	}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(candidates), func(i, j int) {
-		candidates[i], candidates[j] = candidates[j], candidates[i]
-	})
+	owners, err := p.assigner.PickFrom(ctx, tx, team.TeamName, ownersForFiles(rules, changedFiles), []string{authorID}, team.RequiredReviewers)
+	if err != nil {
+		return nil, "", err
+	}
 
-	n := 2
-	if len(candidates) < n {
-		n = len(candidates)
+	if len(owners) >= team.RequiredReviewers {
+		return owners, team.TeamName, nil
 	}
 
-	reviewers := make([]string, n)
-	for i := 0; i < n; i++ {
-		reviewers[i] = candidates[i].UserID
+	exclude := append([]string{authorID}, owners...)
+
+	rest, reviewerTeam, err := p.assigner.Pick(ctx, tx, reviewerSourceTeams(team), exclude, team.RequiredReviewers-len(owners))
+	if err != nil {
+		if errors.Is(err, ErrReviewerCapacityExceeded) && len(owners) > 0 {
+			return owners, team.TeamName, nil
+		}
+		return nil, "", err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, err
+	if len(owners) > 0 && len(rest) == 0 {
+		reviewerTeam = team.TeamName
 	}
 
-	return reviewers, nil
+	return append(owners, rest...), reviewerTeam, nil
 }
 
 func (p *PullRequestServiceImpl) CreatePullRequest(ctx context.Context, req *api.PostPullRequestCreateJSONRequestBody) (*models.PullRequest, error) {
@@ -146,27 +148,33 @@ func (p *PullRequestServiceImpl) CreatePullRequest(ctx context.Context, req *api
 		return nil, err
 	}
 
-	reviewers, err := p.GetUsersForPR(ctx, req.AuthorId)
-	if err != nil {
-		return nil, err
-	}
+	status := entity.StatusOpen
+	reviewers := []string{}
+	reviewerTeam := ""
 
-	var reviewer1, reviewer2 string
-	if len(reviewers) > 0 {
-		reviewer1 = reviewers[0]
+	changedFiles := []string{}
+	if req.ChangedFiles != nil {
+		changedFiles = *req.ChangedFiles
 	}
-	if len(reviewers) > 1 {
-		reviewer2 = reviewers[1]
+
+	if req.Draft != nil && *req.Draft {
+		status = entity.StatusDraft
+	} else {
+		reviewers, reviewerTeam, err = p.GetUsersForPR(ctx, tx, req.AuthorId, changedFiles)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	pullRequestEntity := &entity.PullRequest{
-		AuthorID:                req.AuthorId,
-		PullRequestID:           req.PullRequestId,
-		PullRequestName:         req.PullRequestName,
-		Status:                  entity.StatusOpen,
-		MergedAt:                nil,
-		AssignedReviewersFirst:  reviewer1,
-		AssignedReviewersSecond: reviewer2,
+		AuthorID:          req.AuthorId,
+		PullRequestID:     req.PullRequestId,
+		PullRequestName:   req.PullRequestName,
+		Status:            status,
+		MergedAt:          nil,
+		AssignedReviewers: reviewers,
+		ChangedFiles:      changedFiles,
+		ReviewerTeam:      reviewerTeam,
 	}
 
 	err = p.prRepo.CreatePullRequest(ctx, tx, pullRequestEntity)
@@ -174,23 +182,34 @@ func (p *PullRequestServiceImpl) CreatePullRequest(ctx context.Context, req *api
 		return nil, err
 	}
 
-	if err = tx.Commit(); err != nil {
+	if err = p.outboxRepo.AddEvents(ctx, tx, reviewerEvents(entity.EventReviewerAssigned, pullRequestEntity, reviewers)); err != nil {
 		return nil, err
 	}
 
-	pullRequest := &models.PullRequest{
-		PullRequestId:     pullRequestEntity.PullRequestID,
-		PullRequestName:   pullRequestEntity.PullRequestName,
-		AuthorId:          pullRequestEntity.AuthorID,
-		Status:            api.PullRequestStatus(pullRequestEntity.Status),
-		AssignedReviewers: []string{pullRequestEntity.AssignedReviewersFirst, pullRequestEntity.AssignedReviewersSecond},
-		CreatedAt:         &pullRequestEntity.CreatedAt,
+	history := historyEntry(ctx, entity.HistoryPullRequestCreated, nil, pullRequestEntity, "pull request created")
+	if err = p.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, err
 	}
 
-	return pullRequest, nil
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPullRequestModel(pullRequestEntity), nil
 }
 
 func (p *PullRequestServiceImpl) MergePullRequest(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error) {
+	return p.mergePullRequest(ctx, req, true, "pull request merged")
+}
+
+// RecordExternalMerge marks a pull request merged because the forge already
+// merged it. The team's merge policy is not checked: the merge has happened
+// and refusing it would only leave the service out of sync.
+func (p *PullRequestServiceImpl) RecordExternalMerge(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody) (*models.PullRequest, error) {
+	return p.mergePullRequest(ctx, req, false, "pull request merged on the forge")
+}
+
+func (p *PullRequestServiceImpl) mergePullRequest(ctx context.Context, req *api.PostPullRequestMergeJSONRequestBody, enforcePolicy bool, reason string) (*models.PullRequest, error) {
 	if req.PullRequestId == "" {
 		return nil, errors.New("PullRequestId is empty")
 	}
@@ -215,26 +234,209 @@ func (p *PullRequestServiceImpl) MergePullRequest(ctx context.Context, req *api.
 		return nil, sql.ErrNoRows
 	}
 
-	updatedPR, err := p.prRepo.UpdatePullRequestStatus(ctx, tx, req.PullRequestId, "MERGED")
+	if pr.Status == entity.StatusMerged {
+		pr, err = p.withReviews(ctx, tx, pr)
+		if err != nil {
+			return nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, err
+		}
+		return toPullRequestModel(pr), nil
+	}
+
+	if err = validateTransition(pr.Status, entity.StatusMerged); err != nil {
+		return nil, err
+	}
+
+	pr, err = p.withReviews(ctx, tx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	if enforcePolicy {
+		if err = p.checkMergePolicy(ctx, tx, pr); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedPR, err := p.prRepo.UpdatePullRequestStatus(ctx, tx, req.PullRequestId, string(entity.StatusMerged))
+	if err != nil {
+		return nil, err
+	}
+	updatedPR.Reviews = pr.Reviews
+
+	if err = p.outboxRepo.AddEvents(ctx, tx, []entity.Event{mergedEvent(updatedPR)}); err != nil {
+		return nil, err
+	}
+
+	history := historyEntry(ctx, entity.HistoryStatusChanged, pr, updatedPR, reason)
+	if err = p.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPullRequestModel(updatedPR), nil
+}
+
+func (p *PullRequestServiceImpl) ClosePullRequest(ctx context.Context, req *api.PostPullRequestCloseJSONRequestBody) (*models.PullRequest, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+
+	return p.changePullRequestStatus(ctx, req.PullRequestId, entity.StatusClosed)
+}
+
+func (p *PullRequestServiceImpl) ReopenPullRequest(ctx context.Context, req *api.PostPullRequestReopenJSONRequestBody) (*models.PullRequest, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+
+	return p.changePullRequestStatus(ctx, req.PullRequestId, entity.StatusOpen)
+}
+
+func (p *PullRequestServiceImpl) MarkPullRequestDraft(ctx context.Context, req *api.PostPullRequestDraftJSONRequestBody) (*models.PullRequest, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+
+	return p.changePullRequestStatus(ctx, req.PullRequestId, entity.StatusDraft)
+}
+
+func (p *PullRequestServiceImpl) MarkPullRequestReady(ctx context.Context, req *api.PostPullRequestReadyJSONRequestBody) (*models.PullRequest, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+
+	tx, err := p.prRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	pr, err := p.prRepo.GetPullRequestByID(ctx, tx, req.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status != entity.StatusDraft {
+		err = fmt.Errorf("%w: PR %s is not a draft", ErrPullRequestInvalidTransition, pr.PullRequestID)
+		return nil, err
+	}
+
+	updatedPR, err := p.transitionPullRequest(ctx, tx, pr, entity.StatusOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPR, err = p.withReviews(ctx, tx, updatedPR)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.outboxRepo.AddEvents(ctx, tx, reviewerChangeEvents(pr, updatedPR)); err != nil {
+		return nil, err
+	}
+
+	history := historyEntry(ctx, entity.HistoryStatusChanged, pr, updatedPR, statusChangeReason(updatedPR.Status))
+	if err = p.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPullRequestModel(updatedPR), nil
+}
+
+func (p *PullRequestServiceImpl) changePullRequestStatus(ctx context.Context, prID string, status entity.PullRequestStatus) (*models.PullRequest, error) {
+	tx, err := p.prRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	pr, err := p.prRepo.GetPullRequestByID(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPR, err := p.transitionPullRequest(ctx, tx, pr, status)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPR, err = p.withReviews(ctx, tx, updatedPR)
 	if err != nil {
 		return nil, err
 	}
 
-	pullRequest := &models.PullRequest{
-		PullRequestId:     updatedPR.PullRequestID,
-		PullRequestName:   updatedPR.PullRequestName,
-		AuthorId:          updatedPR.AuthorID,
-		Status:            api.PullRequestStatus(updatedPR.Status),
-		AssignedReviewers: []string{updatedPR.AssignedReviewersFirst, updatedPR.AssignedReviewersSecond},
-		CreatedAt:         &updatedPR.CreatedAt,
-		MergedAt:          updatedPR.MergedAt,
+	if err = p.outboxRepo.AddEvents(ctx, tx, reviewerChangeEvents(pr, updatedPR)); err != nil {
+		return nil, err
+	}
+
+	history := historyEntry(ctx, entity.HistoryStatusChanged, pr, updatedPR, statusChangeReason(updatedPR.Status))
+	if err = p.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
-	return pullRequest, nil
+	return toPullRequestModel(updatedPR), nil
+}
+
+func (p *PullRequestServiceImpl) transitionPullRequest(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest, status entity.PullRequestStatus) (*entity.PullRequest, error) {
+	if err := validateTransition(pr.Status, status); err != nil {
+		return nil, err
+	}
+
+	updatedPR, err := p.prRepo.UpdatePullRequestStatus(ctx, tx, pr.PullRequestID, string(status))
+	if err != nil {
+		return nil, err
+	}
+
+	// A draft has no reviewers; they are picked again when it becomes ready.
+	if status == entity.StatusDraft && len(updatedPR.AssignedReviewers) > 0 {
+		if err := p.prRepo.SetPullRequestReviewers(ctx, tx, updatedPR.PullRequestID, []string{}); err != nil {
+			return nil, err
+		}
+		updatedPR.AssignedReviewers = []string{}
+		return updatedPR, nil
+	}
+
+	if status != entity.StatusOpen || len(updatedPR.AssignedReviewers) > 0 {
+		return updatedPR, nil
+	}
+
+	reviewers, reviewerTeam, err := p.GetUsersForPR(ctx, tx, updatedPR.AuthorID, updatedPR.ChangedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.prRepo.SetPullRequestReviewers(ctx, tx, updatedPR.PullRequestID, reviewers); err != nil {
+		return nil, err
+	}
+
+	updatedPR.AssignedReviewers = reviewers
+	updatedPR.ReviewerTeam = reviewerTeam
+
+	return updatedPR, nil
 }
 
 func (p *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req *api.PostPullRequestReassignJSONRequestBody) (*models.PullRequest, string, error) {
@@ -262,17 +464,17 @@ func (p *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req *api.
 	}
 
 	if pr.Status == entity.StatusMerged {
-		return nil, "", ErrPullRequestMerged
+		err = ErrPullRequestMerged
+		return nil, "", err
+	}
+	if pr.Status == entity.StatusClosed {
+		err = ErrPullRequestClosed
+		return nil, "", err
 	}
 
-	var otherReviewer string
-
-	if pr.AssignedReviewersFirst == req.OldUserId {
-		otherReviewer = pr.AssignedReviewersSecond
-	} else if pr.AssignedReviewersSecond == req.OldUserId {
-		otherReviewer = pr.AssignedReviewersFirst
-	} else {
-		return nil, "", ErrPullRequestNotAsigned
+	if !slices.Contains(pr.AssignedReviewers, req.OldUserId) {
+		err = ErrPullRequestNotAsigned
+		return nil, "", err
 	}
 
 	author, err := p.userRepo.GetUserByID(ctx, tx, pr.AuthorID)
@@ -280,59 +482,167 @@ func (p *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, req *api.
 		return nil, "", err
 	}
 
-	users, err := p.userRepo.GetUsersByTeam(ctx, tx, author.TeamName)
+	team, err := p.teamRepo.GetTeamByName(ctx, tx, author.TeamName)
 	if err != nil {
 		return nil, "", err
 	}
 
-	var candidates []*entity.User
-	for _, u := range users {
-		if !u.IsActive {
-			continue
-		}
-		if u.UserID == pr.AuthorID {
-			continue
-		}
-		if u.UserID == req.OldUserId {
-			continue
-		}
-		if u.UserID == otherReviewer {
-			continue
-		}
-		candidates = append(candidates, u)
-	}
+	exclude := append([]string{pr.AuthorID}, pr.AssignedReviewers...)
 
-	if len(candidates) == 0 {
-		return nil, "", ErrPullRequestNoCandidate
+	selected, reviewerTeam, err := p.assigner.Pick(ctx, tx, reviewerSourceTeams(team), exclude, 1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(selected) == 0 {
+		err = ErrPullRequestNoCandidate
+		return nil, "", err
 	}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	newReviewer := candidates[r.Intn(len(candidates))].UserID
+	newReviewer := selected[0]
 
-	var updatedPR *entity.PullRequest
-	if pr.AssignedReviewersFirst == req.OldUserId {
-		updatedPR, err = p.prRepo.UpdatePullRequestReviewers(ctx, tx, req.PullRequestId, newReviewer, otherReviewer)
-	} else {
-		updatedPR, err = p.prRepo.UpdatePullRequestReviewers(ctx, tx, req.PullRequestId, otherReviewer, newReviewer)
+	updatedPR, err := p.prRepo.ReplacePullRequestReviewer(ctx, tx, req.PullRequestId, req.OldUserId, newReviewer)
+	if err != nil {
+		return nil, "", err
 	}
 
+	updatedPR, err = p.withReviews(ctx, tx, updatedPR)
 	if err != nil {
 		return nil, "", err
 	}
+	updatedPR.ReviewerTeam = reviewerTeam
 
-	pullRequest := &models.PullRequest{
-		PullRequestId:     updatedPR.PullRequestID,
-		PullRequestName:   updatedPR.PullRequestName,
-		AuthorId:          updatedPR.AuthorID,
-		Status:            api.PullRequestStatus(updatedPR.Status),
-		AssignedReviewers: []string{updatedPR.AssignedReviewersFirst, updatedPR.AssignedReviewersSecond},
+	if err = p.outboxRepo.AddEvents(ctx, tx, reassignmentEvents(updatedPR, req.OldUserId, &newReviewer)); err != nil {
+		return nil, "", err
+	}
+
+	history := historyEntry(ctx, entity.HistoryReviewerReassigned, pr, updatedPR, reasonOrDefault(req.Reason, "manual reassignment"))
+	if err = p.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, "", err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, "", err
 	}
 
-	return pullRequest, newReviewer, nil
+	return toPullRequestModel(updatedPR), newReviewer, nil
+}
+
+func (p *PullRequestServiceImpl) GetPullRequestHistory(ctx context.Context, req *api.GetPullRequestHistoryParams) ([]models.PullRequestHistory, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+
+	if _, err := p.prRepo.GetPullRequestByID(ctx, nil, req.PullRequestId); err != nil {
+		return nil, err
+	}
+
+	entries, err := p.historyRepo.GetHistory(ctx, nil, req.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]models.PullRequestHistory, 0, len(entries))
+	for _, entry := range entries {
+		history = append(history, toPullRequestHistoryModel(entry))
+	}
+
+	return history, nil
+}
+
+func (p *PullRequestServiceImpl) ReviewPullRequest(ctx context.Context, req *api.PostPullRequestReviewJSONRequestBody) (*models.PullRequest, error) {
+	if req.PullRequestId == "" {
+		return nil, errors.New("PullRequestId is empty")
+	}
+	if req.ReviewerId == "" {
+		return nil, errors.New("ReviewerId is empty")
+	}
+
+	verdict := entity.ReviewVerdict(req.Verdict)
+	switch verdict {
+	case entity.VerdictApprove, entity.VerdictRequestChanges, entity.VerdictComment:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVerdict, req.Verdict)
+	}
+
+	tx, err := p.prRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	pr, err := p.prRepo.GetPullRequestByID(ctx, tx, req.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status == entity.StatusMerged {
+		err = ErrPullRequestMerged
+		return nil, err
+	}
+	if pr.Status == entity.StatusClosed {
+		err = ErrPullRequestClosed
+		return nil, err
+	}
+
+	if req.ReviewerId != pr.AuthorID && !slices.Contains(pr.AssignedReviewers, req.ReviewerId) {
+		err = ErrPullRequestNotAsigned
+		return nil, err
+	}
+
+	review := &entity.Review{
+		PullRequestID: pr.PullRequestID,
+		ReviewerID:    req.ReviewerId,
+		Verdict:       verdict,
+	}
+
+	if err = p.reviewRepo.UpsertReview(ctx, tx, review); err != nil {
+		return nil, err
+	}
+
+	pr, err = p.withReviews(ctx, tx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPullRequestModel(pr), nil
+}
+
+func (p *PullRequestServiceImpl) withReviews(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest) (*entity.PullRequest, error) {
+	reviews, err := p.reviewRepo.GetReviewsByPullRequest(ctx, tx, pr.PullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.Reviews = reviews
+
+	return pr, nil
+}
+
+func (p *PullRequestServiceImpl) checkMergePolicy(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest) error {
+	author, err := p.userRepo.GetUserByID(ctx, tx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	team, err := p.teamRepo.GetTeamByName(ctx, tx, author.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if conditions := unmetMergeConditions(team, pr); len(conditions) > 0 {
+		return &MergeBlockedError{Conditions: conditions}
+	}
+
+	return nil
 }
 
 func (p *PullRequestServiceImpl) GetUserReviewRequests(ctx context.Context, req *api.GetUsersGetReviewParams) ([]*models.PullRequestShort, error) {
@@ -381,3 +691,50 @@ func (p *PullRequestServiceImpl) GetUserReviewRequests(ctx context.Context, req
 
 	return pullRequests, nil
 }
+
+func toPullRequestModel(pr *entity.PullRequest) *models.PullRequest {
+	return &models.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		ChangedFiles:      toOptionalStrings(pr.ChangedFiles),
+		CreatedAt:         &pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
+		Reviews:           toReviewModels(pr.Reviews),
+		ReviewerTeam:      toOptionalString(pr.ReviewerTeam),
+	}
+}
+
+func toOptionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func toOptionalStrings(s []string) *[]string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
+
+func toReviewModels(reviews []*entity.Review) *[]models.PullRequestReview {
+	if reviews == nil {
+		return nil
+	}
+
+	result := make([]models.PullRequestReview, 0, len(reviews))
+	for _, review := range reviews {
+		result = append(result, models.PullRequestReview{
+			ReviewerId:  review.ReviewerID,
+			Verdict:     api.ReviewVerdict(review.Verdict),
+			SubmittedAt: review.UpdatedAt,
+		})
+	}
+
+	return &result
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+var pullRequestTransitions = map[entity.PullRequestStatus][]entity.PullRequestStatus{
+	entity.StatusDraft:  {entity.StatusOpen, entity.StatusClosed},
+	entity.StatusOpen:   {entity.StatusMerged, entity.StatusClosed, entity.StatusDraft},
+	entity.StatusClosed: {entity.StatusOpen},
+	entity.StatusMerged: {},
+}
+
+func validateTransition(from, to entity.PullRequestStatus) error {
+	if slices.Contains(pullRequestTransitions[from], to) {
+		return nil
+	}
+	return fmt.Errorf("%w: cannot change PR status from %s to %s", ErrPullRequestInvalidTransition, from, to)
+}
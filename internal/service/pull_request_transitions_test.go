@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from    entity.PullRequestStatus
+		to      entity.PullRequestStatus
+		allowed bool
+	}{
+		{from: entity.StatusDraft, to: entity.StatusDraft, allowed: false},
+		{from: entity.StatusDraft, to: entity.StatusOpen, allowed: true},
+		{from: entity.StatusDraft, to: entity.StatusMerged, allowed: false},
+		{from: entity.StatusDraft, to: entity.StatusClosed, allowed: true},
+
+		{from: entity.StatusOpen, to: entity.StatusDraft, allowed: true},
+		{from: entity.StatusOpen, to: entity.StatusOpen, allowed: false},
+		{from: entity.StatusOpen, to: entity.StatusMerged, allowed: true},
+		{from: entity.StatusOpen, to: entity.StatusClosed, allowed: true},
+
+		{from: entity.StatusClosed, to: entity.StatusDraft, allowed: false},
+		{from: entity.StatusClosed, to: entity.StatusOpen, allowed: true},
+		{from: entity.StatusClosed, to: entity.StatusMerged, allowed: false},
+		{from: entity.StatusClosed, to: entity.StatusClosed, allowed: false},
+
+		{from: entity.StatusMerged, to: entity.StatusDraft, allowed: false},
+		{from: entity.StatusMerged, to: entity.StatusOpen, allowed: false},
+		{from: entity.StatusMerged, to: entity.StatusMerged, allowed: false},
+		{from: entity.StatusMerged, to: entity.StatusClosed, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			err := validateTransition(tt.from, tt.to)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrPullRequestInvalidTransition)
+			}
+		})
+	}
+}
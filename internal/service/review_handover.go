@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+// reviewHandover moves a reviewer's open reviews to other candidates. It is
+// shared by the user and team services so every path that takes a reviewer
+// out of rotation records the same outbox events and history entries.
+type reviewHandover struct {
+	userRepo    repository.UserRepository
+	teamRepo    repository.TeamRepository
+	prRepo      repository.PullRequestRepository
+	assigner    *ReviewerAssigner
+	outboxRepo  repository.OutboxRepository
+	historyRepo repository.PullRequestHistoryRepository
+}
+
+func newReviewHandover(
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	prRepo repository.PullRequestRepository,
+	assigner *ReviewerAssigner,
+	outboxRepo repository.OutboxRepository,
+	historyRepo repository.PullRequestHistoryRepository,
+) *reviewHandover {
+	return &reviewHandover{
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		prRepo:      prRepo,
+		assigner:    assigner,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+func (h *reviewHandover) handOverReviews(
+	ctx context.Context,
+	tx *sql.Tx,
+	reviewerID string,
+	sourceTeams func(authorTeam *entity.Team) []string,
+	keepWithoutCandidate bool,
+	reason string,
+) ([]models.ReviewReassignment, error) {
+	pullRequests, err := h.prRepo.GetPullRequestsByReviewer(ctx, tx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[string]*entity.Team)
+	reassignments := []models.ReviewReassignment{}
+
+	for _, pr := range pullRequests {
+		if pr.Status != entity.StatusOpen {
+			continue
+		}
+
+		author, err := h.userRepo.GetUserByID(ctx, tx, pr.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+
+		team, ok := teams[author.TeamName]
+		if !ok {
+			team = &entity.Team{}
+			if author.TeamName != "" {
+				team, err = h.teamRepo.GetTeamByName(ctx, tx, author.TeamName)
+				if err != nil {
+					return nil, err
+				}
+			}
+			teams[author.TeamName] = team
+		}
+
+		teamNames := sourceTeams(team)
+		if len(teamNames) == 0 {
+			continue
+		}
+
+		reassignment, err := h.assigner.HandOver(ctx, tx, pr, teamNames, reviewerID, keepWithoutCandidate)
+		if err != nil {
+			return nil, err
+		}
+		reassignments = append(reassignments, reassignment)
+
+		if reassignment.Outcome == api.NoCandidate {
+			continue
+		}
+
+		if err := h.outboxRepo.AddEvents(ctx, tx, reassignmentEvents(pr, reviewerID, reassignment.NewReviewerId)); err != nil {
+			return nil, err
+		}
+
+		history := handOverHistoryEntry(ctx, pr, reviewerID, reassignment.NewReviewerId, reason)
+		if err := h.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+			return nil, err
+		}
+	}
+
+	return reassignments, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+)
+
+type handoverTestService struct {
+	*UserServiceImpl
+	prRepo      *fakePullRequestRepo
+	absenceRepo *fakeAbsenceRepo
+	outboxRepo  *fakeOutboxRepo
+	historyRepo *fakeHistoryRepo
+}
+
+func newHandoverTestService(prs ...*entity.PullRequest) *handoverTestService {
+	userRepo := newFakeUserRepo(
+		&entity.User{UserID: "u1", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u2", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u3", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u4", TeamName: "frontend", IsActive: true},
+	)
+	teamRepo := newFakeTeamRepo(
+		&entity.Team{TeamName: "backend", RequiredReviewers: 2},
+		&entity.Team{TeamName: "frontend", RequiredReviewers: 2},
+	)
+	prRepo := newFakePullRequestRepo(prs...)
+	absenceRepo := &fakeAbsenceRepo{}
+	outboxRepo := newFakeOutboxRepo()
+	historyRepo := &fakeHistoryRepo{}
+	selectors := NewReviewerSelectors(config.ReviewersConfig{Strategy: config.ReviewerStrategyRoundRobin}, prRepo, teamRepo)
+	assigner := NewReviewerAssigner(userRepo, prRepo, absenceRepo, selectors)
+
+	return &handoverTestService{
+		UserServiceImpl: &UserServiceImpl{
+			userRepo:    userRepo,
+			teamRepo:    teamRepo,
+			prRepo:      prRepo,
+			absenceRepo: absenceRepo,
+			handover:    newReviewHandover(userRepo, teamRepo, prRepo, assigner, outboxRepo, historyRepo),
+		},
+		prRepo:      prRepo,
+		absenceRepo: absenceRepo,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+func TestMoveUserToTeam_RemovesReviewerWithoutOldTeamCandidates(t *testing.T) {
+	svc := newHandoverTestService(
+		&entity.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2", "u3"}, Status: entity.StatusOpen},
+		&entity.PullRequest{PullRequestID: "pr-2", AuthorID: "u1", AssignedReviewers: []string{"u2"}, Status: entity.StatusOpen},
+	)
+
+	user, reassignments, err := svc.MoveUserToTeam(context.Background(), &api.PostUsersMoveTeamJSONRequestBody{UserId: "u2", TeamName: "frontend"})
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", user.TeamName)
+
+	require.Len(t, reassignments, 2)
+
+	assert.Equal(t, "pr-1", reassignments[0].PullRequestId)
+	assert.Equal(t, api.Removed, reassignments[0].Outcome)
+	assert.Nil(t, reassignments[0].NewReviewerId)
+	assert.Equal(t, []string{"u3"}, svc.prRepo.prs["pr-1"].AssignedReviewers)
+
+	assert.Equal(t, "pr-2", reassignments[1].PullRequestId)
+	assert.Equal(t, api.Reassigned, reassignments[1].Outcome)
+	require.NotNil(t, reassignments[1].NewReviewerId)
+	assert.Equal(t, "u3", *reassignments[1].NewReviewerId)
+	assert.Equal(t, []string{"u3"}, svc.prRepo.prs["pr-2"].AssignedReviewers)
+
+	require.Len(t, svc.historyRepo.entries, 2)
+	assert.Equal(t, entity.HistoryReviewerRemoved, svc.historyRepo.entries[0].Action)
+	assert.Equal(t, entity.HistoryReviewerReassigned, svc.historyRepo.entries[1].Action)
+}
+
+func TestHandOverStartedAbsences_KeepsReviewerWithoutCandidate(t *testing.T) {
+	svc := newHandoverTestService(
+		&entity.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2", "u3"}, Status: entity.StatusOpen},
+		&entity.PullRequest{PullRequestID: "pr-2", AuthorID: "u1", AssignedReviewers: []string{"u2"}, Status: entity.StatusOpen},
+	)
+	svc.absenceRepo.absent = []string{"u2"}
+	svc.absenceRepo.started = []*entity.Absence{{ID: 7, UserID: "u2"}}
+
+	reassignments, err := svc.HandOverStartedAbsences(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, reassignments, 2)
+
+	assert.Equal(t, "pr-1", reassignments[0].PullRequestId)
+	assert.Equal(t, api.NoCandidate, reassignments[0].Outcome)
+	assert.Nil(t, reassignments[0].NewReviewerId)
+	assert.Equal(t, []string{"u2", "u3"}, svc.prRepo.prs["pr-1"].AssignedReviewers)
+
+	assert.Equal(t, "pr-2", reassignments[1].PullRequestId)
+	assert.Equal(t, api.Reassigned, reassignments[1].Outcome)
+	assert.Equal(t, []string{"u3"}, svc.prRepo.prs["pr-2"].AssignedReviewers)
+
+	require.NotEmpty(t, svc.outboxRepo.added)
+	for _, event := range svc.outboxRepo.added {
+		assert.Equal(t, "pr-2", event.PullRequestID)
+	}
+	require.Len(t, svc.historyRepo.entries, 1)
+	assert.Equal(t, "pr-2", svc.historyRepo.entries[0].PullRequestID)
+	assert.Equal(t, []int{7}, svc.absenceRepo.handedOver)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+type ReviewReminderServiceImpl struct {
+	logger      *slog.Logger
+	prRepo      repository.PullRequestRepository
+	teamRepo    repository.TeamRepository
+	assigner    *ReviewerAssigner
+	outboxRepo  repository.OutboxRepository
+	historyRepo repository.PullRequestHistoryRepository
+	cfg         config.RemindersConfig
+}
+
+func NewReviewReminderService(
+	logger *slog.Logger,
+	prRepo repository.PullRequestRepository,
+	teamRepo repository.TeamRepository,
+	assigner *ReviewerAssigner,
+	outboxRepo repository.OutboxRepository,
+	historyRepo repository.PullRequestHistoryRepository,
+	cfg config.RemindersConfig,
+) ReviewReminderService {
+	return &ReviewReminderServiceImpl{
+		logger:      logger,
+		prRepo:      prRepo,
+		teamRepo:    teamRepo,
+		assigner:    assigner,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+		cfg:         cfg,
+	}
+}
+
+func (r *ReviewReminderServiceImpl) ProcessSilentReviews(ctx context.Context) (int, int, error) {
+	tx, err := r.prRepo.BeginTx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	reviews, err := r.prRepo.GetSilentReviews(ctx, tx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	events := []entity.Event{}
+	stale := map[string]*entity.Event{}
+	staleOrder := []string{}
+	reminded, escalated := 0, 0
+
+	for _, review := range reviews {
+		policy := r.cfg.PolicyForTeam(review.TeamName)
+
+		switch {
+		case policy.EscalateAfter > 0 && review.Waiting >= policy.EscalateAfter:
+			var escalationEvents []entity.Event
+			escalationEvents, err = r.escalate(ctx, tx, review, policy)
+			if err != nil {
+				return 0, 0, err
+			}
+			events = append(events, escalationEvents...)
+			escalated++
+		case policy.RemindAfter > 0 && review.Waiting >= policy.RemindAfter && !review.Reminded:
+			if err = r.prRepo.MarkReviewReminded(ctx, tx, review.PullRequestID, review.ReviewerID); err != nil {
+				return 0, 0, err
+			}
+
+			event, ok := stale[review.PullRequestID]
+			if !ok {
+				event = &entity.Event{
+					Type:            entity.EventPullRequestStale,
+					OccurredAt:      time.Now().UTC(),
+					PullRequestID:   review.PullRequestID,
+					PullRequestName: review.PullRequestName,
+					AuthorID:        review.AuthorID,
+				}
+				stale[review.PullRequestID] = event
+				staleOrder = append(staleOrder, review.PullRequestID)
+			}
+			event.AssignedReviewers = append(event.AssignedReviewers, review.ReviewerID)
+			reminded++
+		}
+	}
+
+	for _, prID := range staleOrder {
+		events = append(events, *stale[prID])
+	}
+
+	if err = r.outboxRepo.AddEvents(ctx, tx, events); err != nil {
+		return 0, 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+
+	return reminded, escalated, nil
+}
+
+func (r *ReviewReminderServiceImpl) escalate(ctx context.Context, tx *sql.Tx, review *entity.SilentReview, policy config.ReminderPolicy) ([]entity.Event, error) {
+	if policy.Action == config.ReminderActionEscalate {
+		if err := r.prRepo.MarkReviewEscalated(ctx, tx, review.PullRequestID, review.ReviewerID); err != nil {
+			return nil, err
+		}
+
+		return []entity.Event{escalatedEvent(review, policy.Lead)}, nil
+	}
+
+	pr, err := r.prRepo.GetPullRequestByID(ctx, tx, review.PullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := []string{}
+	if review.TeamName != "" {
+		team, err := r.teamRepo.GetTeamByName(ctx, tx, review.TeamName)
+		if err != nil {
+			return nil, err
+		}
+
+		exclude := append([]string{pr.AuthorID}, pr.AssignedReviewers...)
+
+		selected, _, err = r.assigner.Pick(ctx, tx, reviewerSourceTeams(team), exclude, 1)
+		if err != nil && !errors.Is(err, ErrReviewerCapacityExceeded) {
+			return nil, err
+		}
+	}
+
+	if len(selected) == 0 {
+		r.logger.Warn("No replacement for silent reviewer",
+			slog.String("pull_request_id", review.PullRequestID),
+			slog.String("reviewer_id", review.ReviewerID),
+		)
+		if err := r.prRepo.MarkReviewEscalated(ctx, tx, review.PullRequestID, review.ReviewerID); err != nil {
+			return nil, err
+		}
+
+		// Nobody can take the review over, so at least let people know it is stuck.
+		if policy.Lead != "" {
+			return []entity.Event{escalatedEvent(review, policy.Lead)}, nil
+		}
+		return []entity.Event{{
+			Type:              entity.EventPullRequestStale,
+			OccurredAt:        time.Now().UTC(),
+			PullRequestID:     review.PullRequestID,
+			PullRequestName:   review.PullRequestName,
+			AuthorID:          review.AuthorID,
+			AssignedReviewers: []string{review.ReviewerID},
+		}}, nil
+	}
+
+	updatedPR, err := r.prRepo.ReplacePullRequestReviewer(ctx, tx, review.PullRequestID, review.ReviewerID, selected[0])
+	if err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("no review from %s for %s", review.ReviewerID, review.Waiting.Round(time.Minute))
+	history := historyEntry(ctx, entity.HistoryReviewerReassigned, pr, updatedPR, reason)
+	if err := r.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("Silent reviewer reassigned",
+		slog.String("pull_request_id", review.PullRequestID),
+		slog.String("old_reviewer_id", review.ReviewerID),
+		slog.String("new_reviewer_id", selected[0]),
+	)
+
+	return reassignmentEvents(updatedPR, review.ReviewerID, &selected[0]), nil
+}
+
+func escalatedEvent(review *entity.SilentReview, lead string) entity.Event {
+	return entity.Event{
+		Type:            entity.EventPullRequestEscalated,
+		OccurredAt:      time.Now().UTC(),
+		PullRequestID:   review.PullRequestID,
+		PullRequestName: review.PullRequestName,
+		AuthorID:        review.AuthorID,
+		ReviewerID:      review.ReviewerID,
+		EscalatedTo:     lead,
+	}
+}
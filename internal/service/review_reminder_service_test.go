@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+type reminderFixture struct {
+	svc         ReviewReminderService
+	prRepo      *fakePullRequestRepo
+	outboxRepo  *fakeOutboxRepo
+	historyRepo *fakeHistoryRepo
+}
+
+func newReminderFixture(silent ...*entity.SilentReview) *reminderFixture {
+	userRepo := newFakeUserRepo(
+		&entity.User{UserID: "u1", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u2", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u3", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "u4", TeamName: "backend", IsActive: true},
+		&entity.User{UserID: "s1", TeamName: "solo", IsActive: true},
+		&entity.User{UserID: "s2", TeamName: "solo", IsActive: true},
+	)
+	teamRepo := newFakeTeamRepo(
+		&entity.Team{TeamName: "backend", RequiredReviewers: 2},
+		&entity.Team{TeamName: "solo", RequiredReviewers: 1},
+	)
+	prRepo := newFakePullRequestRepo(
+		&entity.PullRequest{PullRequestID: "pr-1", PullRequestName: "Backend", AuthorID: "u1", AssignedReviewers: []string{"u2", "u3"}, Status: entity.StatusOpen},
+		&entity.PullRequest{PullRequestID: "pr-2", PullRequestName: "Solo", AuthorID: "s1", AssignedReviewers: []string{"s2"}, Status: entity.StatusOpen},
+	)
+	prRepo.silent = silent
+
+	outboxRepo := newFakeOutboxRepo()
+	historyRepo := &fakeHistoryRepo{}
+	selectors := NewReviewerSelectors(config.ReviewersConfig{Strategy: config.ReviewerStrategyRoundRobin}, prRepo, teamRepo)
+
+	cfg := config.RemindersConfig{
+		ReminderPolicy: config.ReminderPolicy{
+			RemindAfter:   time.Hour,
+			EscalateAfter: 4 * time.Hour,
+			Action:        config.ReminderActionReassign,
+		},
+		Teams: map[string]config.ReminderPolicy{
+			"payments": {Action: config.ReminderActionEscalate, Lead: "lead1"},
+		},
+	}
+
+	return &reminderFixture{
+		svc: NewReviewReminderService(
+			slog.New(slog.NewTextHandler(io.Discard, nil)),
+			prRepo,
+			teamRepo,
+			NewReviewerAssigner(userRepo, prRepo, &fakeAbsenceRepo{}, selectors),
+			outboxRepo,
+			historyRepo,
+			cfg,
+		),
+		prRepo:      prRepo,
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+func silentReview(prID, authorID, teamName, reviewerID string, waiting time.Duration, reminded bool) *entity.SilentReview {
+	return &entity.SilentReview{
+		PullRequestID: prID,
+		AuthorID:      authorID,
+		TeamName:      teamName,
+		ReviewerID:    reviewerID,
+		Waiting:       waiting,
+		Reminded:      reminded,
+	}
+}
+
+func TestProcessSilentReviews_RemindsOncePerPullRequest(t *testing.T) {
+	f := newReminderFixture(
+		silentReview("pr-1", "u1", "backend", "u2", 2*time.Hour, false),
+		silentReview("pr-1", "u1", "backend", "u3", 2*time.Hour, false),
+		silentReview("pr-2", "s1", "solo", "s2", 2*time.Hour, true),
+		silentReview("pr-2", "s1", "solo", "s2", 30*time.Minute, false),
+	)
+
+	reminded, escalated, err := f.svc.ProcessSilentReviews(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, reminded)
+	assert.Equal(t, 0, escalated)
+	assert.Equal(t, []string{"pr-1/u2", "pr-1/u3"}, f.prRepo.reminded)
+	require.Len(t, f.outboxRepo.added, 1)
+	assert.Equal(t, entity.EventPullRequestStale, f.outboxRepo.added[0].Type)
+	assert.Equal(t, "pr-1", f.outboxRepo.added[0].PullRequestID)
+	assert.Equal(t, []string{"u2", "u3"}, f.outboxRepo.added[0].AssignedReviewers)
+}
+
+func TestProcessSilentReviews_Escalation(t *testing.T) {
+	tests := []struct {
+		name       string
+		review     *entity.SilentReview
+		lead       string
+		wantEvents []entity.Event
+		reviewers  []string
+		history    int
+	}{
+		{
+			name:   "reassigned to free candidate",
+			review: silentReview("pr-1", "u1", "backend", "u2", 5*time.Hour, true),
+			wantEvents: []entity.Event{
+				{Type: entity.EventReviewerUnassigned, PullRequestID: "pr-1", ReviewerID: "u2", NewReviewerID: "u4"},
+				{Type: entity.EventReviewerAssigned, PullRequestID: "pr-1", ReviewerID: "u4", OldReviewerID: "u2"},
+			},
+			reviewers: []string{"u4", "u3"},
+			history:   1,
+		},
+		{
+			name:   "escalate action notifies lead",
+			review: silentReview("pr-1", "u1", "payments", "u2", 5*time.Hour, true),
+			wantEvents: []entity.Event{
+				{Type: entity.EventPullRequestEscalated, PullRequestID: "pr-1", ReviewerID: "u2", EscalatedTo: "lead1"},
+			},
+			reviewers: []string{"u2", "u3"},
+		},
+		{
+			name:   "no replacement falls back to lead",
+			review: silentReview("pr-2", "s1", "solo", "s2", 5*time.Hour, true),
+			lead:   "lead2",
+			wantEvents: []entity.Event{
+				{Type: entity.EventPullRequestEscalated, PullRequestID: "pr-2", ReviewerID: "s2", EscalatedTo: "lead2"},
+			},
+			reviewers: []string{"s2"},
+		},
+		{
+			name:   "no replacement and no lead publishes stale",
+			review: silentReview("pr-2", "s1", "solo", "s2", 5*time.Hour, true),
+			wantEvents: []entity.Event{
+				{Type: entity.EventPullRequestStale, PullRequestID: "pr-2", AssignedReviewers: []string{"s2"}},
+			},
+			reviewers: []string{"s2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newReminderFixture(tt.review)
+			if tt.lead != "" {
+				f.svc.(*ReviewReminderServiceImpl).cfg.Lead = tt.lead
+			}
+
+			reminded, escalated, err := f.svc.ProcessSilentReviews(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, 0, reminded)
+			assert.Equal(t, 1, escalated)
+			require.Len(t, f.outboxRepo.added, len(tt.wantEvents))
+			for i, want := range tt.wantEvents {
+				got := f.outboxRepo.added[i]
+				assert.Equal(t, want.Type, got.Type)
+				assert.Equal(t, want.PullRequestID, got.PullRequestID)
+				assert.Equal(t, want.ReviewerID, got.ReviewerID)
+				assert.Equal(t, want.OldReviewerID, got.OldReviewerID)
+				assert.Equal(t, want.NewReviewerID, got.NewReviewerID)
+				assert.Equal(t, want.EscalatedTo, got.EscalatedTo)
+				assert.Equal(t, want.AssignedReviewers, got.AssignedReviewers)
+			}
+			assert.Equal(t, tt.reviewers, f.prRepo.prs[tt.review.PullRequestID].AssignedReviewers)
+			assert.Len(t, f.historyRepo.entries, tt.history)
+
+			if tt.history == 0 {
+				assert.Equal(t, []string{tt.review.PullRequestID + "/" + tt.review.ReviewerID}, f.prRepo.escalated)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+var ErrReviewerCapacityExceeded = errors.New("all candidate reviewers are at capacity")
+
+type ReviewerAssigner struct {
+	userRepo    repository.UserRepository
+	prRepo      repository.PullRequestRepository
+	absenceRepo repository.AbsenceRepository
+	selectors   *ReviewerSelectors
+}
+
+func NewReviewerAssigner(
+	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	absenceRepo repository.AbsenceRepository,
+	selectors *ReviewerSelectors,
+) *ReviewerAssigner {
+	return &ReviewerAssigner{
+		userRepo:    userRepo,
+		prRepo:      prRepo,
+		absenceRepo: absenceRepo,
+		selectors:   selectors,
+	}
+}
+
+func (a *ReviewerAssigner) Pick(ctx context.Context, tx *sql.Tx, teamNames []string, exclude []string, n int) ([]string, string, error) {
+	atCapacity := false
+
+	for _, teamName := range teamNames {
+		users, err := a.userRepo.GetUsersByTeam(ctx, tx, teamName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		reviewers, full, err := a.selectFrom(ctx, tx, teamName, users, exclude, n)
+		if err != nil {
+			return nil, "", err
+		}
+		if full {
+			atCapacity = true
+		}
+		if len(reviewers) == 0 {
+			continue
+		}
+
+		return reviewers, teamName, nil
+	}
+
+	if atCapacity {
+		return nil, "", ErrReviewerCapacityExceeded
+	}
+
+	return []string{}, "", nil
+}
+
+func (a *ReviewerAssigner) PickFrom(ctx context.Context, tx *sql.Tx, teamName string, userIDs []string, exclude []string, n int) ([]string, error) {
+	var users []*entity.User
+	for _, userID := range userIDs {
+		user, err := a.userRepo.GetUserByID(ctx, tx, userID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	reviewers, _, err := a.selectFrom(ctx, tx, teamName, users, exclude, n)
+	return reviewers, err
+}
+
+func (a *ReviewerAssigner) selectFrom(ctx context.Context, tx *sql.Tx, teamName string, users []*entity.User, exclude []string, n int) ([]string, bool, error) {
+	var candidates []*entity.User
+	for _, u := range users {
+		if !u.IsActive {
+			continue
+		}
+		if slices.Contains(exclude, u.UserID) {
+			continue
+		}
+		candidates = append(candidates, u)
+	}
+
+	candidates, err := a.withoutAbsent(ctx, tx, candidates)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, false, nil
+	}
+
+	candidates, err = a.withinCapacity(ctx, tx, candidates)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, true, nil
+	}
+
+	selected, err := a.selectors.ForTeam(teamName).Select(ctx, tx, teamName, candidates, n)
+	if err != nil {
+		return nil, false, err
+	}
+
+	reviewers := make([]string, len(selected))
+	for i, u := range selected {
+		reviewers[i] = u.UserID
+	}
+
+	return reviewers, false, nil
+}
+
+// HandOver replaces reviewerID on pr with a candidate from teamNames. When
+// there is none, the reviewer is removed, unless keepWithoutCandidate is set
+// because they are only away for a while.
+func (a *ReviewerAssigner) HandOver(ctx context.Context, tx *sql.Tx, pr *entity.PullRequest, teamNames []string, reviewerID string, keepWithoutCandidate bool) (models.ReviewReassignment, error) {
+	reassignment := models.ReviewReassignment{
+		PullRequestId: pr.PullRequestID,
+		OldReviewerId: reviewerID,
+	}
+
+	exclude := append([]string{pr.AuthorID}, pr.AssignedReviewers...)
+
+	selected, _, err := a.Pick(ctx, tx, teamNames, exclude, 1)
+	if err != nil && !errors.Is(err, ErrReviewerCapacityExceeded) {
+		return reassignment, err
+	}
+
+	if len(selected) == 0 && keepWithoutCandidate {
+		reassignment.Outcome = api.NoCandidate
+		return reassignment, nil
+	}
+
+	if len(selected) == 0 {
+		reassignment.Outcome = api.Removed
+		return reassignment, a.prRepo.RemovePullRequestReviewer(ctx, tx, pr.PullRequestID, reviewerID)
+	}
+
+	if _, err := a.prRepo.ReplacePullRequestReviewer(ctx, tx, pr.PullRequestID, reviewerID, selected[0]); err != nil {
+		return reassignment, err
+	}
+
+	reassignment.NewReviewerId = &selected[0]
+	reassignment.Outcome = api.Reassigned
+
+	return reassignment, nil
+}
+
+func (a *ReviewerAssigner) withoutAbsent(ctx context.Context, tx *sql.Tx, candidates []*entity.User) ([]*entity.User, error) {
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	userIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		userIDs[i] = c.UserID
+	}
+
+	absent, err := a.absenceRepo.GetAbsentUserIDs(ctx, tx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]*entity.User, 0, len(candidates))
+	for _, c := range candidates {
+		if !slices.Contains(absent, c.UserID) {
+			available = append(available, c)
+		}
+	}
+
+	return available, nil
+}
+
+func (a *ReviewerAssigner) withinCapacity(ctx context.Context, tx *sql.Tx, candidates []*entity.User) ([]*entity.User, error) {
+	var limited []string
+	for _, c := range candidates {
+		if c.MaxOpenReviews != nil {
+			limited = append(limited, c.UserID)
+		}
+	}
+
+	if len(limited) == 0 {
+		return candidates, nil
+	}
+
+	loads, err := a.prRepo.CountOpenReviews(ctx, tx, limited)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]*entity.User, 0, len(candidates))
+	for _, c := range candidates {
+		if c.MaxOpenReviews == nil || loads[c.UserID] < *c.MaxOpenReviews {
+			available = append(available, c)
+		}
+	}
+
+	return available, nil
+}
+
+func reviewerSourceTeams(team *entity.Team) []string {
+	return append([]string{team.TeamName}, team.FallbackTeams...)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+type ReviewerSelector interface {
+	Select(ctx context.Context, tx *sql.Tx, teamName string, candidates []*entity.User, n int) ([]*entity.User, error)
+}
+
+type RandomSelector struct{}
+
+func NewRandomSelector() *RandomSelector {
+	return &RandomSelector{}
+}
+
+func (s *RandomSelector) Select(_ context.Context, _ *sql.Tx, _ string, candidates []*entity.User, n int) ([]*entity.User, error) {
+	shuffled := make([]*entity.User, len(candidates))
+	copy(shuffled, candidates)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled[:min(n, len(shuffled))], nil
+}
+
+// RoundRobinSelector keeps its position per team in the teams table and
+// moves it in the caller's transaction, so the rotation is shared between
+// replicas and a rolled back assignment does not advance it.
+type RoundRobinSelector struct {
+	teamRepo repository.TeamRepository
+}
+
+func NewRoundRobinSelector(teamRepo repository.TeamRepository) *RoundRobinSelector {
+	return &RoundRobinSelector{
+		teamRepo: teamRepo,
+	}
+}
+
+func (s *RoundRobinSelector) Select(ctx context.Context, tx *sql.Tx, teamName string, candidates []*entity.User, n int) ([]*entity.User, error) {
+	if len(candidates) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	ordered := make([]*entity.User, len(candidates))
+	copy(ordered, candidates)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].UserID < ordered[j].UserID
+	})
+
+	last, err := s.teamRepo.GetRoundRobinCursor(ctx, tx, teamName)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	start := sort.Search(len(ordered), func(i int) bool {
+		return ordered[i].UserID > last
+	}) % len(ordered)
+
+	n = min(n, len(ordered))
+	selected := make([]*entity.User, 0, n)
+	for i := 0; i < n; i++ {
+		selected = append(selected, ordered[(start+i)%len(ordered)])
+	}
+
+	if err := s.teamRepo.SetRoundRobinCursor(ctx, tx, teamName, selected[len(selected)-1].UserID); err != nil {
+		return nil, err
+	}
+
+	return selected, nil
+}
+
+type LeastLoadedSelector struct {
+	prRepo repository.PullRequestRepository
+}
+
+func NewLeastLoadedSelector(prRepo repository.PullRequestRepository) *LeastLoadedSelector {
+	return &LeastLoadedSelector{
+		prRepo: prRepo,
+	}
+}
+
+func (s *LeastLoadedSelector) Select(ctx context.Context, tx *sql.Tx, _ string, candidates []*entity.User, n int) ([]*entity.User, error) {
+	if len(candidates) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	userIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		userIDs[i] = c.UserID
+	}
+
+	loads, err := s.prRepo.CountOpenReviews(ctx, tx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make([]*entity.User, len(candidates))
+	copy(ordered, candidates)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.Shuffle(len(ordered), func(i, j int) {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	})
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return loads[ordered[i].UserID] < loads[ordered[j].UserID]
+	})
+
+	return ordered[:min(n, len(ordered))], nil
+}
+
+type ReviewerSelectors struct {
+	cfg        config.ReviewersConfig
+	strategies map[string]ReviewerSelector
+}
+
+func NewReviewerSelectors(cfg config.ReviewersConfig, prRepo repository.PullRequestRepository, teamRepo repository.TeamRepository) *ReviewerSelectors {
+	return &ReviewerSelectors{
+		cfg: cfg,
+		strategies: map[string]ReviewerSelector{
+			config.ReviewerStrategyRandom:      NewRandomSelector(),
+			config.ReviewerStrategyRoundRobin:  NewRoundRobinSelector(teamRepo),
+			config.ReviewerStrategyLeastLoaded: NewLeastLoadedSelector(prRepo),
+		},
+	}
+}
+
+func (s *ReviewerSelectors) ForTeam(teamName string) ReviewerSelector {
+	if selector, ok := s.strategies[s.cfg.StrategyForTeam(teamName)]; ok {
+		return selector
+	}
+	return s.strategies[config.ReviewerStrategyRandom]
+}
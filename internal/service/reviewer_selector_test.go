@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func selectorCandidates(userIDs ...string) []*entity.User {
+	candidates := make([]*entity.User, len(userIDs))
+	for i, userID := range userIDs {
+		candidates[i] = &entity.User{UserID: userID}
+	}
+	return candidates
+}
+
+func selectedIDs(users []*entity.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.UserID
+	}
+	return ids
+}
+
+func TestRandomSelector(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []string
+		n          int
+		want       int
+	}{
+		{name: "no candidates", candidates: nil, n: 2, want: 0},
+		{name: "fewer candidates than requested", candidates: []string{"u1"}, n: 2, want: 1},
+		{name: "exactly as requested", candidates: []string{"u1", "u2"}, n: 2, want: 2},
+		{name: "more candidates than requested", candidates: []string{"u1", "u2", "u3", "u4"}, n: 2, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := NewRandomSelector().Select(context.Background(), nil, "backend", selectorCandidates(tt.candidates...), tt.n)
+			require.NoError(t, err)
+
+			ids := selectedIDs(selected)
+			assert.Len(t, ids, tt.want)
+			assert.Subset(t, tt.candidates, ids)
+			for i := range ids {
+				assert.NotContains(t, ids[i+1:], ids[i])
+			}
+		})
+	}
+}
+
+func TestRoundRobinSelector(t *testing.T) {
+	tests := []struct {
+		name       string
+		cursor     string
+		candidates []string
+		n          int
+		want       []string
+		wantCursor string
+	}{
+		{name: "starts from the first user", candidates: []string{"u2", "u1", "u3"}, n: 2, want: []string{"u1", "u2"}, wantCursor: "u2"},
+		{name: "continues after the cursor", cursor: "u1", candidates: []string{"u1", "u2", "u3"}, n: 1, want: []string{"u2"}, wantCursor: "u2"},
+		{name: "wraps around", cursor: "u2", candidates: []string{"u1", "u2", "u3"}, n: 2, want: []string{"u3", "u1"}, wantCursor: "u1"},
+		{name: "wraps after the last user", cursor: "u3", candidates: []string{"u1", "u2", "u3"}, n: 1, want: []string{"u1"}, wantCursor: "u1"},
+		{name: "cursor user no longer a candidate", cursor: "u2", candidates: []string{"u1", "u3", "u4"}, n: 2, want: []string{"u3", "u4"}, wantCursor: "u4"},
+		{name: "fewer candidates than requested", cursor: "u1", candidates: []string{"u1", "u2"}, n: 3, want: []string{"u2", "u1"}, wantCursor: "u1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := newFakeTeamRepo()
+			if tt.cursor != "" {
+				teamRepo.cursors["backend"] = tt.cursor
+			}
+
+			selected, err := NewRoundRobinSelector(teamRepo).Select(context.Background(), nil, "backend", selectorCandidates(tt.candidates...), tt.n)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, selectedIDs(selected))
+			assert.Equal(t, tt.wantCursor, teamRepo.cursors["backend"])
+		})
+	}
+}
+
+func TestRoundRobinSelector_RotationIsSharedThroughTheTeam(t *testing.T) {
+	teamRepo := newFakeTeamRepo()
+	candidates := selectorCandidates("u1", "u2", "u3")
+
+	var got [][]string
+	for range 3 {
+		// A fresh selector per call stands in for another replica.
+		selected, err := NewRoundRobinSelector(teamRepo).Select(context.Background(), nil, "backend", candidates, 2)
+		require.NoError(t, err)
+		got = append(got, selectedIDs(selected))
+	}
+
+	assert.Equal(t, [][]string{{"u1", "u2"}, {"u3", "u1"}, {"u2", "u3"}}, got)
+}
+
+func TestLeastLoadedSelector(t *testing.T) {
+	tests := []struct {
+		name       string
+		loads      map[string]int
+		candidates []string
+		n          int
+		want       []string
+	}{
+		{name: "picks the least loaded", loads: map[string]int{"u1": 3, "u2": 0, "u3": 1}, candidates: []string{"u1", "u2", "u3"}, n: 2, want: []string{"u2", "u3"}},
+		{name: "users without reviews count as idle", loads: map[string]int{"u1": 2, "u2": 1}, candidates: []string{"u1", "u2", "u3"}, n: 1, want: []string{"u3"}},
+		{name: "orders by load", loads: map[string]int{"u1": 5, "u2": 3, "u3": 4}, candidates: []string{"u1", "u2", "u3"}, n: 3, want: []string{"u2", "u3", "u1"}},
+		{name: "no candidates", candidates: nil, n: 2, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := newFakePullRequestRepo()
+			prRepo.loads = tt.loads
+
+			selected, err := NewLeastLoadedSelector(prRepo).Select(context.Background(), nil, "backend", selectorCandidates(tt.candidates...), tt.n)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, selectedIDs(selected))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackMessage struct {
+	Channel string       `json:"channel,omitempty"`
+	Text    string       `json:"text"`
+	Blocks  []slackBlock `json:"blocks"`
+}
+
+type SlackNotifier struct {
+	logger   *slog.Logger
+	userRepo repository.UserRepository
+	cfg      config.SlackConfig
+}
+
+func NewSlackNotifier(logger *slog.Logger, userRepo repository.UserRepository, cfg config.SlackConfig) *SlackNotifier {
+	return &SlackNotifier{
+		logger:   logger,
+		userRepo: userRepo,
+		cfg:      cfg,
+	}
+}
+
+func (s *SlackNotifier) Deliveries(ctx context.Context, event entity.Event) ([]EventDelivery, error) {
+	if !s.cfg.Enabled() {
+		return nil, nil
+	}
+
+	teamName, err := s.teamOf(ctx, event.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	route := s.cfg.RouteForTeam(teamName)
+	if route.WebhookURL == "" {
+		return nil, nil
+	}
+
+	message, ok := s.render(event, teamName)
+	if !ok {
+		return nil, nil
+	}
+	message.Channel = route.Channel
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return []EventDelivery{{
+		Target:  "slack",
+		URL:     route.WebhookURL,
+		Payload: payload,
+		Attrs: []any{
+			slog.String("sink", "slack"),
+			slog.String("event", string(event.Type)),
+			slog.Int64("event_id", event.ID),
+			slog.String("team_name", teamName),
+		},
+	}}, nil
+}
+
+func (s *SlackNotifier) teamOf(ctx context.Context, userID string) (string, error) {
+	user, err := s.userRepo.GetUserByID(ctx, nil, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return user.TeamName, nil
+}
+
+func (s *SlackNotifier) render(event entity.Event, teamName string) (*slackMessage, bool) {
+	pr := fmt.Sprintf("*%s* (`%s`)", slackEscape(event.PullRequestName), slackEscape(event.PullRequestID))
+
+	var icon, title, body, fallback string
+
+	switch {
+	case event.Type == entity.EventReviewerAssigned && event.OldReviewerID == "":
+		icon, title = ":eyes:", "Review requested"
+		body = fmt.Sprintf("%s was assigned to review %s", s.mention(event.ReviewerID), pr)
+		fallback = fmt.Sprintf("%s was assigned to review %s", event.ReviewerID, event.PullRequestID)
+	case event.Type == entity.EventReviewerAssigned:
+		icon, title = ":twisted_rightwards_arrows:", "Review reassigned"
+		body = fmt.Sprintf("%s was reassigned from %s to %s", pr, s.mention(event.OldReviewerID), s.mention(event.ReviewerID))
+		fallback = fmt.Sprintf("%s was reassigned from %s to %s", event.PullRequestID, event.OldReviewerID, event.ReviewerID)
+	case event.Type == entity.EventReviewerUnassigned && event.NewReviewerID == "":
+		icon, title = ":warning:", "Reviewer removed"
+		body = fmt.Sprintf("%s was removed from %s and no replacement was available", s.mention(event.ReviewerID), pr)
+		fallback = fmt.Sprintf("%s was removed from %s", event.ReviewerID, event.PullRequestID)
+	case event.Type == entity.EventPullRequestStale:
+		icon, title = ":hourglass_flowing_sand:", "Review is overdue"
+		body = fmt.Sprintf("%s is still waiting for review", pr)
+		if len(event.AssignedReviewers) > 0 {
+			body += " from " + s.mentions(event.AssignedReviewers)
+		}
+		fallback = fmt.Sprintf("%s is still waiting for review", event.PullRequestID)
+	case event.Type == entity.EventPullRequestEscalated:
+		icon, title = ":rotating_light:", "Review escalated"
+		body = fmt.Sprintf("%s has not reviewed %s, escalated to %s", s.mention(event.ReviewerID), pr, s.mention(event.EscalatedTo))
+		fallback = fmt.Sprintf("%s has not reviewed %s, escalated to %s", event.ReviewerID, event.PullRequestID, event.EscalatedTo)
+	default:
+		return nil, false
+	}
+
+	footer := "Author: " + s.mention(event.AuthorID)
+	if teamName != "" {
+		footer += " · Team: " + slackEscape(teamName)
+	}
+
+	return &slackMessage{
+		Text: slackEscape(fallback),
+		Blocks: []slackBlock{
+			{Type: "section", Text: &slackText{Type: "mrkdwn", Text: fmt.Sprintf("%s *%s*\n%s", icon, title, body)}},
+			{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: footer}}},
+		},
+	}, true
+}
+
+func (s *SlackNotifier) mention(userID string) string {
+	if memberID, ok := s.cfg.MemberIDForUser(userID); ok {
+		return "<@" + memberID + ">"
+	}
+	return "`" + slackEscape(userID) + "`"
+}
+
+func (s *SlackNotifier) mentions(userIDs []string) string {
+	mentions := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		mentions = append(mentions, s.mention(userID))
+	}
+	return strings.Join(mentions, ", ")
+}
+
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
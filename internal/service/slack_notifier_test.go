@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
+)
+
+func newTestSlackNotifier(cfg config.SlackConfig) *SlackNotifier {
+	userRepo := newFakeUserRepo(
+		&entity.User{UserID: "u1", TeamName: "backend"},
+		&entity.User{UserID: "u9", TeamName: "mobile"},
+	)
+	return NewSlackNotifier(slog.New(slog.NewTextHandler(io.Discard, nil)), userRepo, cfg)
+}
+
+func TestSlackNotifierRender(t *testing.T) {
+	notifier := newTestSlackNotifier(config.SlackConfig{
+		WebhookURL: "https://hooks.slack.test/default",
+		Users:      map[string]string{"u2": "U02"},
+	})
+
+	base := entity.Event{PullRequestID: "pr-1", PullRequestName: "Fix <login> & logout", AuthorID: "u1"}
+	with := func(modify func(*entity.Event)) entity.Event {
+		event := base
+		modify(&event)
+		return event
+	}
+
+	tests := []struct {
+		name     string
+		event    entity.Event
+		section  string
+		fallback string
+	}{
+		{
+			name:     "review requested",
+			event:    with(func(e *entity.Event) { e.Type, e.ReviewerID = entity.EventReviewerAssigned, "u2" }),
+			section:  ":eyes: *Review requested*\n<@U02> was assigned to review *Fix &lt;login&gt; &amp; logout* (`pr-1`)",
+			fallback: "u2 was assigned to review pr-1",
+		},
+		{
+			name: "review reassigned",
+			event: with(func(e *entity.Event) {
+				e.Type, e.ReviewerID, e.OldReviewerID = entity.EventReviewerAssigned, "u2", "u3"
+			}),
+			section:  ":twisted_rightwards_arrows: *Review reassigned*\n*Fix &lt;login&gt; &amp; logout* (`pr-1`) was reassigned from `u3` to <@U02>",
+			fallback: "pr-1 was reassigned from u3 to u2",
+		},
+		{
+			name:     "reviewer removed",
+			event:    with(func(e *entity.Event) { e.Type, e.ReviewerID = entity.EventReviewerUnassigned, "u3" }),
+			section:  ":warning: *Reviewer removed*\n`u3` was removed from *Fix &lt;login&gt; &amp; logout* (`pr-1`) and no replacement was available",
+			fallback: "u3 was removed from pr-1",
+		},
+		{
+			name: "review overdue",
+			event: with(func(e *entity.Event) {
+				e.Type, e.AssignedReviewers = entity.EventPullRequestStale, []string{"u2", "u3"}
+			}),
+			section:  ":hourglass_flowing_sand: *Review is overdue*\n*Fix &lt;login&gt; &amp; logout* (`pr-1`) is still waiting for review from <@U02>, `u3`",
+			fallback: "pr-1 is still waiting for review",
+		},
+		{
+			name: "review escalated",
+			event: with(func(e *entity.Event) {
+				e.Type, e.ReviewerID, e.EscalatedTo = entity.EventPullRequestEscalated, "u3", "u2"
+			}),
+			section:  ":rotating_light: *Review escalated*\n`u3` has not reviewed *Fix &lt;login&gt; &amp; logout* (`pr-1`), escalated to <@U02>",
+			fallback: "u3 has not reviewed pr-1, escalated to u2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, ok := notifier.render(tt.event, "backend")
+			require.True(t, ok)
+
+			assert.Equal(t, tt.fallback, message.Text)
+			require.Len(t, message.Blocks, 2)
+			assert.Equal(t, "section", message.Blocks[0].Type)
+			assert.Equal(t, tt.section, message.Blocks[0].Text.Text)
+			assert.Equal(t, "context", message.Blocks[1].Type)
+			assert.Equal(t, "Author: `u1` · Team: backend", message.Blocks[1].Elements[0].Text)
+		})
+	}
+}
+
+func TestSlackNotifierRender_SkipsOtherEvents(t *testing.T) {
+	notifier := newTestSlackNotifier(config.SlackConfig{WebhookURL: "https://hooks.slack.test/default"})
+
+	for _, event := range []entity.Event{
+		{Type: entity.EventPullRequestMerged, PullRequestID: "pr-1"},
+		{Type: entity.EventReviewerUnassigned, PullRequestID: "pr-1", ReviewerID: "u2", NewReviewerID: "u3"},
+	} {
+		_, ok := notifier.render(event, "")
+		assert.False(t, ok, event.Type)
+	}
+}
+
+func TestSlackNotifierDeliveries_RoutesByTeamWebhook(t *testing.T) {
+	notifier := newTestSlackNotifier(config.SlackConfig{
+		WebhookURL: "https://hooks.slack.test/default",
+		Channel:    "#code-review",
+		Teams: map[string]config.SlackRoute{
+			"backend": {WebhookURL: "https://hooks.slack.test/backend"},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		authorID string
+		url      string
+	}{
+		{"team route", "u1", "https://hooks.slack.test/backend"},
+		{"default route", "u9", "https://hooks.slack.test/default"},
+		{"unknown author", "ghost", "https://hooks.slack.test/default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveries, err := notifier.Deliveries(context.Background(), entity.Event{
+				Type:       entity.EventReviewerAssigned,
+				AuthorID:   tt.authorID,
+				ReviewerID: "u2",
+			})
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, "slack", deliveries[0].Target)
+			assert.Equal(t, tt.url, deliveries[0].URL)
+
+			var message slackMessage
+			require.NoError(t, json.Unmarshal(deliveries[0].Payload, &message))
+			assert.Equal(t, "#code-review", message.Channel)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+var ErrInvalidStatsRange = errors.New("from must be before to")
+
+type StatsServiceImpl struct {
+	logger    *slog.Logger
+	statsRepo repository.StatsRepository
+	teamRepo  repository.TeamRepository
+}
+
+func NewStatsService(logger *slog.Logger, statsRepo repository.StatsRepository, teamRepo repository.TeamRepository) StatsService {
+	return &StatsServiceImpl{
+		logger:    logger,
+		statsRepo: statsRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+func (s *StatsServiceImpl) GetReviewerStats(ctx context.Context, req *api.GetStatsReviewersParams) ([]models.ReviewerStats, error) {
+	filter, err := s.statsFilter(ctx, req.TeamName, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetReviewerStats(ctx, nil, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.ReviewerStats, 0, len(stats))
+	for _, st := range stats {
+		result = append(result, toReviewerStatsModel(st))
+	}
+
+	return result, nil
+}
+
+func (s *StatsServiceImpl) GetTeamStats(ctx context.Context, req *api.GetStatsTeamsParams) ([]models.TeamWeekStats, error) {
+	filter, err := s.statsFilter(ctx, req.TeamName, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetTeamWeeklyStats(ctx, nil, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.TeamWeekStats, 0, len(stats))
+	for _, st := range stats {
+		result = append(result, toTeamWeekStatsModel(st))
+	}
+
+	return result, nil
+}
+
+func (s *StatsServiceImpl) statsFilter(ctx context.Context, teamName *string, from, to *time.Time) (entity.StatsFilter, error) {
+	filter := entity.StatsFilter{}
+
+	if teamName != nil && *teamName != "" {
+		exists, err := s.teamRepo.TeamExists(ctx, nil, *teamName)
+		if err != nil {
+			return filter, err
+		}
+		if !exists {
+			return filter, ErrTeamNotFound
+		}
+		filter.TeamName = *teamName
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return filter, ErrInvalidStatsRange
+	}
+
+	if from != nil {
+		utc := from.UTC()
+		filter.From = &utc
+	}
+	if to != nil {
+		utc := to.UTC()
+		filter.To = &utc
+	}
+
+	return filter, nil
+}
+
+func toReviewerStatsModel(stats *entity.ReviewerStats) models.ReviewerStats {
+	model := models.ReviewerStats{
+		UserId:         stats.UserID,
+		Username:       stats.Username,
+		TeamName:       stats.TeamName,
+		IsActive:       stats.IsActive,
+		AssignedOpen:   stats.OpenReviews,
+		AssignedMerged: stats.MergedReviews,
+		ReassignedAway: stats.ReassignedAway,
+	}
+
+	model.MedianTimeToMergeSeconds = durationSeconds(stats.MedianTimeToMerge)
+
+	return model
+}
+
+func toTeamWeekStatsModel(stats *entity.TeamWeekStats) models.TeamWeekStats {
+	model := models.TeamWeekStats{
+		TeamName:                 stats.TeamName,
+		WeekStart:                openapi_types.Date{Time: stats.WeekStart},
+		Opened:                   stats.Opened,
+		Merged:                   stats.Merged,
+		MedianOpenToMergeSeconds: durationSeconds(stats.MedianOpenToMerge),
+		P90OpenToMergeSeconds:    durationSeconds(stats.P90OpenToMerge),
+	}
+
+	if stats.OpenedForReview > 0 {
+		share := float64(stats.UnderReviewed) / float64(stats.OpenedForReview)
+		model.UnderReviewedShare = &share
+	}
+
+	return model
+}
+
+func durationSeconds(duration *time.Duration) *float64 {
+	if duration == nil {
+		return nil
+	}
+
+	seconds := duration.Seconds()
+	return &seconds
+}
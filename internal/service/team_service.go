@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 
 	"github.com/oooooorg/PR-Service/internal/entity"
 	api "github.com/oooooorg/PR-Service/internal/gen"
@@ -14,22 +17,34 @@ import (
 
 var ErrTeamExists = errors.New("team already exists")
 var ErrTeamNotFound = errors.New("team not found")
+var ErrHasOpenPullRequests = errors.New("open pull requests exist")
+var ErrInvalidOwnershipRule = errors.New("invalid ownership rule")
 
 type TeamServiceImpl struct {
-	logger   *slog.Logger
-	userRepo repository.UserRepository
-	teamRepo repository.TeamRepository
+	logger      *slog.Logger
+	userRepo    repository.UserRepository
+	teamRepo    repository.TeamRepository
+	prRepo      repository.PullRequestRepository
+	historyRepo repository.PullRequestHistoryRepository
+	handover    *reviewHandover
 }
 
 func NewTeamService(
 	logger *slog.Logger,
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
+	prRepo repository.PullRequestRepository,
+	assigner *ReviewerAssigner,
+	outboxRepo repository.OutboxRepository,
+	historyRepo repository.PullRequestHistoryRepository,
 ) TeamService {
 	return &TeamServiceImpl{
-		logger:   logger,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
+		logger:      logger,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		prRepo:      prRepo,
+		historyRepo: historyRepo,
+		handover:    newReviewHandover(userRepo, teamRepo, prRepo, assigner, outboxRepo, historyRepo),
 	}
 }
 
@@ -41,6 +56,22 @@ func (t *TeamServiceImpl) CreateTeam(ctx context.Context, team *api.Team) (*mode
 		return nil, errors.New("team must have at least one member")
 	}
 
+	requiredReviewers := entity.DefaultRequiredReviewers
+	if team.RequiredReviewers != nil {
+		requiredReviewers = *team.RequiredReviewers
+	}
+	if requiredReviewers < 1 {
+		return nil, errors.New("required reviewers must be at least 1")
+	}
+
+	requiredApprovals := 0
+	if team.RequiredApprovals != nil {
+		requiredApprovals = *team.RequiredApprovals
+	}
+	if requiredApprovals < 0 {
+		return nil, errors.New("required approvals must not be negative")
+	}
+
 	tx, err := t.userRepo.BeginTx(ctx)
 	if err != nil {
 		return nil, err
@@ -61,7 +92,21 @@ func (t *TeamServiceImpl) CreateTeam(ctx context.Context, team *api.Team) (*mode
 	}
 
 	teamEntity := &entity.Team{
-		TeamName: team.TeamName,
+		TeamName:          team.TeamName,
+		RequiredReviewers: requiredReviewers,
+		RequiredApprovals: requiredApprovals,
+	}
+	if team.BlockOnChangesRequested != nil {
+		teamEntity.BlockOnChangesRequested = *team.BlockOnChangesRequested
+	}
+	if team.AllowSelfApproval != nil {
+		teamEntity.AllowSelfApproval = *team.AllowSelfApproval
+	}
+	if team.FallbackTeams != nil {
+		teamEntity.FallbackTeams, err = t.validateFallbackTeams(ctx, tx, team.TeamName, *team.FallbackTeams)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = t.teamRepo.CreateTeam(ctx, tx, teamEntity)
@@ -80,10 +125,11 @@ func (t *TeamServiceImpl) CreateTeam(ctx context.Context, team *api.Team) (*mode
 		}
 
 		userEntity := &entity.User{
-			UserID:   member.UserId,
-			Username: member.Username,
-			IsActive: member.IsActive,
-			TeamName: team.TeamName,
+			UserID:         member.UserId,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			TeamName:       team.TeamName,
+			MaxOpenReviews: member.MaxOpenReviews,
 		}
 
 		err = t.userRepo.CreateUser(ctx, tx, userEntity)
@@ -136,15 +182,22 @@ func (t *TeamServiceImpl) GetTeam(ctx context.Context, req *api.GetTeamGetParams
 	members := make([]models.TeamMember, 0, len(users))
 	for _, u := range users {
 		members = append(members, models.TeamMember{
-			IsActive: u.IsActive,
-			UserId:   u.UserID,
-			Username: u.Username,
+			IsActive:       u.IsActive,
+			UserId:         u.UserID,
+			Username:       u.Username,
+			MaxOpenReviews: u.MaxOpenReviews,
 		})
 	}
 
 	team := &models.Team{
-		TeamName: teamEntity.TeamName,
-		Members:  members,
+		TeamName:          teamEntity.TeamName,
+		Members:           members,
+		RequiredReviewers: &teamEntity.RequiredReviewers,
+		RequiredApprovals: &teamEntity.RequiredApprovals,
+
+		BlockOnChangesRequested: &teamEntity.BlockOnChangesRequested,
+		AllowSelfApproval:       &teamEntity.AllowSelfApproval,
+		FallbackTeams:           &teamEntity.FallbackTeams,
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -152,3 +205,507 @@ func (t *TeamServiceImpl) GetTeam(ctx context.Context, req *api.GetTeamGetParams
 	}
 	return team, nil
 }
+
+func (t *TeamServiceImpl) UpdateTeam(ctx context.Context, req *api.PostTeamUpdateJSONRequestBody) (*models.Team, error) {
+	if req.TeamName == "" {
+		return nil, errors.New("team name is required")
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	teamEntity, err := t.teamRepo.GetTeamByName(ctx, tx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if req.NewTeamName != nil && *req.NewTeamName != req.TeamName {
+		if *req.NewTeamName == "" {
+			err = errors.New("new team name must not be empty")
+			return nil, err
+		}
+
+		var exists bool
+		exists, err = t.teamRepo.TeamExists(ctx, tx, *req.NewTeamName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check team existence: %w", err)
+		}
+		if exists {
+			err = ErrTeamExists
+			return nil, err
+		}
+
+		teamEntity.TeamName = *req.NewTeamName
+	}
+
+	if req.RequiredReviewers != nil {
+		if *req.RequiredReviewers < 1 {
+			err = errors.New("required reviewers must be at least 1")
+			return nil, err
+		}
+		teamEntity.RequiredReviewers = *req.RequiredReviewers
+	}
+	if req.RequiredApprovals != nil {
+		if *req.RequiredApprovals < 0 {
+			err = errors.New("required approvals must not be negative")
+			return nil, err
+		}
+		teamEntity.RequiredApprovals = *req.RequiredApprovals
+	}
+	if req.BlockOnChangesRequested != nil {
+		teamEntity.BlockOnChangesRequested = *req.BlockOnChangesRequested
+	}
+	if req.AllowSelfApproval != nil {
+		teamEntity.AllowSelfApproval = *req.AllowSelfApproval
+	}
+	if req.FallbackTeams != nil {
+		teamEntity.FallbackTeams, err = t.validateFallbackTeams(ctx, tx, teamEntity.TeamName, *req.FallbackTeams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = t.teamRepo.UpdateTeam(ctx, tx, req.TeamName, teamEntity); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return t.GetTeam(ctx, &models.TeamGetParams{
+		TeamName: teamEntity.TeamName,
+	})
+}
+
+func (t *TeamServiceImpl) AddTeamMembers(ctx context.Context, req *api.PostTeamAddMembersJSONRequestBody) (*models.Team, error) {
+	if req.TeamName == "" {
+		return nil, errors.New("team name is required")
+	}
+	if len(req.Members) == 0 {
+		return nil, errors.New("at least one member is required")
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	exists, err := t.teamRepo.TeamExists(ctx, tx, req.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		err = ErrTeamNotFound
+		return nil, err
+	}
+
+	for _, member := range req.Members {
+		if member.UserId == "" {
+			err = errors.New("user ID is required for all members")
+			return nil, err
+		}
+
+		var existing *entity.User
+		existing, err = t.userRepo.GetUserByID(ctx, tx, member.UserId)
+		if err == nil {
+			if existing.TeamName != "" {
+				err = fmt.Errorf("%w: %s", ErrUserExists, member.UserId)
+				return nil, err
+			}
+
+			// Users removed from a team or left behind by a deleted one keep
+			// their row with no team, so they are re-attached instead.
+			if err = t.reattachUser(ctx, tx, req.TeamName, member); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		userEntity := &entity.User{
+			UserID:         member.UserId,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			TeamName:       req.TeamName,
+			MaxOpenReviews: member.MaxOpenReviews,
+		}
+
+		if err = t.userRepo.CreateUser(ctx, tx, userEntity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return t.GetTeam(ctx, &models.TeamGetParams{
+		TeamName: req.TeamName,
+	})
+}
+
+func (t *TeamServiceImpl) reattachUser(ctx context.Context, tx *sql.Tx, teamName string, member api.TeamMember) error {
+	if _, err := t.userRepo.SetUserTeam(ctx, tx, member.UserId, teamName); err != nil {
+		return err
+	}
+	if _, err := t.userRepo.SetUserActive(ctx, tx, member.UserId, member.IsActive); err != nil {
+		return err
+	}
+	if _, err := t.userRepo.SetUserMaxOpenReviews(ctx, tx, member.UserId, member.MaxOpenReviews); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TeamServiceImpl) RemoveTeamMember(ctx context.Context, req *api.PostTeamRemoveMemberJSONRequestBody) (*models.Team, error) {
+	if req.TeamName == "" {
+		return nil, errors.New("team name is required")
+	}
+	if req.UserId == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	user, err := t.userRepo.GetUserByID(ctx, tx, req.UserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrUserNotFound
+		}
+		return nil, err
+	}
+	if user.TeamName != req.TeamName {
+		err = ErrUserNotFound
+		return nil, err
+	}
+
+	openPRs, err := t.prRepo.GetOpenPullRequestsByUsers(ctx, tx, []string{req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	if len(openPRs) > 0 {
+		err = fmt.Errorf("%w: %s has %d open pull requests", ErrHasOpenPullRequests, req.UserId, len(openPRs))
+		return nil, err
+	}
+
+	if _, err = t.userRepo.RemoveUserFromTeam(ctx, tx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return t.GetTeam(ctx, &models.TeamGetParams{
+		TeamName: req.TeamName,
+	})
+}
+
+func (t *TeamServiceImpl) DeleteTeam(ctx context.Context, req *api.PostTeamDeleteJSONRequestBody) ([]string, []models.ReviewReassignment, error) {
+	if req.TeamName == "" {
+		return nil, nil, errors.New("team name is required")
+	}
+
+	policy := api.REJECT
+	if req.OpenPrs != nil {
+		policy = *req.OpenPrs
+	}
+	if policy != api.REJECT && policy != api.CLOSE {
+		return nil, nil, fmt.Errorf("unknown open pull requests policy: %s", policy)
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	exists, err := t.teamRepo.TeamExists(ctx, tx, req.TeamName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		err = ErrTeamNotFound
+		return nil, nil, err
+	}
+
+	users, err := t.userRepo.GetUsersByTeam(ctx, tx, req.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memberIDs := make([]string, len(users))
+	for i, u := range users {
+		memberIDs[i] = u.UserID
+	}
+
+	openPRs, err := t.prRepo.GetOpenPullRequestsByUsers(ctx, tx, memberIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closed := []string{}
+	for _, pr := range openPRs {
+		if !slices.Contains(memberIDs, pr.AuthorID) {
+			continue
+		}
+
+		if policy == api.REJECT {
+			err = fmt.Errorf("%w: team %s has open pull requests", ErrHasOpenPullRequests, req.TeamName)
+			return nil, nil, err
+		}
+
+		var updatedPR *entity.PullRequest
+		updatedPR, err = t.prRepo.UpdatePullRequestStatus(ctx, tx, pr.PullRequestID, string(entity.StatusClosed))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		history := historyEntry(ctx, entity.HistoryStatusChanged, pr, updatedPR, fmt.Sprintf("team %s deleted", req.TeamName))
+		if err = t.historyRepo.AddEntries(ctx, tx, []entity.PullRequestHistoryEntry{history}); err != nil {
+			return nil, nil, err
+		}
+		closed = append(closed, pr.PullRequestID)
+	}
+
+	if err = t.userRepo.DeactivateUsersByTeam(ctx, tx, req.TeamName); err != nil {
+		return nil, nil, err
+	}
+
+	// Members may still review pull requests of other teams. They are
+	// deactivated first, so the handover does not pick them for each other.
+	reason := fmt.Sprintf("team %s deleted", req.TeamName)
+	reassignments := []models.ReviewReassignment{}
+	for _, id := range memberIDs {
+		var handedOver []models.ReviewReassignment
+		handedOver, err = t.handover.handOverReviews(ctx, tx, id, reviewerSourceTeams, false, reason)
+		if err != nil {
+			return nil, nil, err
+		}
+		reassignments = append(reassignments, handedOver...)
+	}
+
+	if err = t.teamRepo.DeleteTeam(ctx, tx, req.TeamName); err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return closed, reassignments, nil
+}
+
+func (t *TeamServiceImpl) GetOwnershipRules(ctx context.Context, req *api.GetTeamGetOwnershipRulesParams) ([]models.OwnershipRule, error) {
+	if req.TeamName == "" {
+		return nil, errors.New("team name is required")
+	}
+
+	exists, err := t.teamRepo.TeamExists(ctx, nil, req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	rules, err := t.teamRepo.GetOwnershipRules(ctx, nil, req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOwnershipRuleModels(rules), nil
+}
+
+func (t *TeamServiceImpl) SetOwnershipRules(ctx context.Context, req *api.PostTeamSetOwnershipRulesJSONRequestBody) ([]models.OwnershipRule, error) {
+	if req.TeamName == "" {
+		return nil, errors.New("team name is required")
+	}
+
+	rules := make([]*entity.OwnershipRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		pattern := strings.TrimSpace(rule.Pattern)
+		if err := validateOwnershipPattern(pattern); err != nil {
+			return nil, err
+		}
+		if len(rule.Owners) == 0 {
+			return nil, fmt.Errorf("%w: rule %s has no owners", ErrInvalidOwnershipRule, pattern)
+		}
+
+		rules = append(rules, &entity.OwnershipRule{
+			Pattern: pattern,
+			Owners:  rule.Owners,
+		})
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	unknown, err := t.replaceOwnershipRules(ctx, tx, req.TeamName, rules)
+	if err != nil {
+		return nil, err
+	}
+	if len(unknown) > 0 {
+		err = fmt.Errorf("%w: %s", ErrUserNotFound, strings.Join(unknown, ", "))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toOwnershipRuleModels(rules), nil
+}
+
+func (t *TeamServiceImpl) ImportCodeowners(ctx context.Context, req *api.PostTeamImportCodeownersJSONRequestBody) ([]models.OwnershipRule, []string, error) {
+	if req.TeamName == "" {
+		return nil, nil, errors.New("team name is required")
+	}
+
+	rules, err := parseCodeowners(req.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := t.teamRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	unknown, err := t.replaceOwnershipRules(ctx, tx, req.TeamName, rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return toOwnershipRuleModels(rules), unknown, nil
+}
+
+func (t *TeamServiceImpl) replaceOwnershipRules(ctx context.Context, tx *sql.Tx, teamName string, rules []*entity.OwnershipRule) ([]string, error) {
+	exists, err := t.teamRepo.TeamExists(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	unknown := []string{}
+	known := make(map[string]bool)
+
+	for _, rule := range rules {
+		owners := make([]string, 0, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			isKnown, checked := known[owner]
+			if !checked {
+				_, err = t.userRepo.GetUserByID(ctx, tx, owner)
+				if err != nil && !errors.Is(err, sql.ErrNoRows) {
+					return nil, err
+				}
+				isKnown = err == nil
+				known[owner] = isKnown
+				if !isKnown {
+					unknown = append(unknown, owner)
+				}
+			}
+
+			if isKnown && !slices.Contains(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+		rule.Owners = owners
+	}
+
+	if err = t.teamRepo.SetOwnershipRules(ctx, tx, teamName, rules); err != nil {
+		return nil, err
+	}
+
+	return unknown, nil
+}
+
+func (t *TeamServiceImpl) validateFallbackTeams(ctx context.Context, tx *sql.Tx, teamName string, fallbackTeams []string) ([]string, error) {
+	validated := make([]string, 0, len(fallbackTeams))
+	for _, fallbackTeam := range fallbackTeams {
+		if fallbackTeam == teamName {
+			return nil, errors.New("team cannot be its own fallback team")
+		}
+		if slices.Contains(validated, fallbackTeam) {
+			return nil, fmt.Errorf("duplicate fallback team: %s", fallbackTeam)
+		}
+
+		exists, err := t.teamRepo.TeamExists(ctx, tx, fallbackTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check team existence: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", ErrTeamNotFound, fallbackTeam)
+		}
+
+		validated = append(validated, fallbackTeam)
+	}
+
+	return validated, nil
+}
+
+func toOwnershipRuleModels(rules []*entity.OwnershipRule) []models.OwnershipRule {
+	result := make([]models.OwnershipRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, models.OwnershipRule{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		})
+	}
+	return result
+}
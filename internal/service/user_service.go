@@ -2,9 +2,14 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 
+	"github.com/oooooorg/PR-Service/internal/config"
+	"github.com/oooooorg/PR-Service/internal/entity"
 	api "github.com/oooooorg/PR-Service/internal/gen"
 	"github.com/oooooorg/PR-Service/internal/models"
 	"github.com/oooooorg/PR-Service/internal/repository"
@@ -12,31 +17,266 @@ import (
 
 var ErrUserNotFound = errors.New("team already exists")
 var ErrUserExists = errors.New("user already exists")
+var ErrAbsenceNotFound = errors.New("absence not found")
+var ErrInvalidAbsenceWindow = errors.New("absence must end after it starts")
+var ErrInvalidMaxOpenReviews = errors.New("max_open_reviews must not be negative")
 
 type UserServiceImpl struct {
-	logger   *slog.Logger
-	userRepo repository.UserRepository
-	teamRepo repository.TeamRepository
+	logger      *slog.Logger
+	userRepo    repository.UserRepository
+	teamRepo    repository.TeamRepository
+	prRepo      repository.PullRequestRepository
+	absenceRepo repository.AbsenceRepository
+	handover    *reviewHandover
+	cfg         config.ReviewersConfig
 }
 
 func NewUserService(
 	logger *slog.Logger,
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
+	prRepo repository.PullRequestRepository,
+	assigner *ReviewerAssigner,
+	absenceRepo repository.AbsenceRepository,
+	outboxRepo repository.OutboxRepository,
+	historyRepo repository.PullRequestHistoryRepository,
+	cfg config.ReviewersConfig,
 ) UserService {
 	return &UserServiceImpl{
-		logger:   logger,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
+		logger:      logger,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		prRepo:      prRepo,
+		absenceRepo: absenceRepo,
+		handover:    newReviewHandover(userRepo, teamRepo, prRepo, assigner, outboxRepo, historyRepo),
+		cfg:         cfg,
 	}
 }
 
-func (u *UserServiceImpl) SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, error) {
+func (u *UserServiceImpl) SetUserActive(ctx context.Context, req *api.PostUsersSetIsActiveJSONRequestBody) (*models.User, []models.ReviewReassignment, error) {
+	if req.UserId == "" {
+		return nil, nil, errors.New("UserId is required")
+	}
+
+	tx, err := u.userRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = u.userRepo.GetUserByID(ctx, tx, req.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedUser, err := u.userRepo.SetUserActive(ctx, tx, req.UserId, req.IsActive)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reassign := u.cfg.ShouldReassignOnDeactivate()
+	if req.ReassignReviews != nil {
+		reassign = *req.ReassignReviews
+	}
+
+	reassignments := []models.ReviewReassignment{}
+	if !req.IsActive && reassign {
+		reason := reasonOrDefault(req.Reason, fmt.Sprintf("user %s deactivated", req.UserId))
+		reassignments, err = u.handover.handOverReviews(ctx, tx, req.UserId, reviewerSourceTeams, false, reason)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return toUserModel(updatedUser), reassignments, nil
+}
+
+func (u *UserServiceImpl) SetMaxOpenReviews(ctx context.Context, req *api.PostUsersSetMaxOpenReviewsJSONRequestBody) (*models.User, error) {
 	if req.UserId == "" {
 		return nil, errors.New("UserId is required")
 	}
+	if req.MaxOpenReviews != nil && *req.MaxOpenReviews < 0 {
+		return nil, ErrInvalidMaxOpenReviews
+	}
+
+	user, err := u.userRepo.SetUserMaxOpenReviews(ctx, nil, req.UserId, req.MaxOpenReviews)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return toUserModel(user), nil
+}
+
+func (u *UserServiceImpl) MoveUserToTeam(ctx context.Context, req *api.PostUsersMoveTeamJSONRequestBody) (*models.User, []models.ReviewReassignment, error) {
+	if req.UserId == "" {
+		return nil, nil, errors.New("UserId is required")
+	}
+	if req.TeamName == "" {
+		return nil, nil, errors.New("TeamName is required")
+	}
+
+	tx, err := u.userRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	user, err := u.userRepo.GetUserByID(ctx, tx, req.UserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrUserNotFound
+		}
+		return nil, nil, err
+	}
+
+	exists, err := u.teamRepo.TeamExists(ctx, tx, req.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		err = ErrTeamNotFound
+		return nil, nil, err
+	}
+
+	reassignments := []models.ReviewReassignment{}
+	oldTeamName := user.TeamName
+
+	if oldTeamName != req.TeamName {
+		user, err = u.userRepo.SetUserTeam(ctx, tx, req.UserId, req.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if oldTeamName != "" {
+			reason := fmt.Sprintf("user %s moved from team %s to %s", req.UserId, oldTeamName, req.TeamName)
+			reassignments, err = u.handover.handOverReviews(ctx, tx, req.UserId, func(authorTeam *entity.Team) []string {
+				if authorTeam.TeamName != oldTeamName {
+					return nil
+				}
+				return []string{oldTeamName}
+			}, false, reason)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return toUserModel(user), reassignments, nil
+}
+
+func (u *UserServiceImpl) BulkDeactivate(ctx context.Context, req *api.PostUsersBulkDeactivateJSONRequestBody) ([]*models.User, []models.ReviewReassignment, error) {
+	hasTeam := req.TeamName != nil && *req.TeamName != ""
+	hasUsers := req.UserIds != nil && len(*req.UserIds) > 0
+	if hasTeam == hasUsers {
+		return nil, nil, errors.New("exactly one of team_name or user_ids is required")
+	}
 
 	tx, err := u.userRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var userIDs []string
+	if hasTeam {
+		var exists bool
+		exists, err = u.teamRepo.TeamExists(ctx, tx, *req.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			err = ErrTeamNotFound
+			return nil, nil, err
+		}
+
+		var members []*entity.User
+		members, err = u.userRepo.GetUsersByTeam(ctx, tx, *req.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, member := range members {
+			userIDs = append(userIDs, member.UserID)
+		}
+	} else {
+		for _, userID := range *req.UserIds {
+			if !slices.Contains(userIDs, userID) {
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+
+	users := make([]*models.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		var user *entity.User
+		user, err = u.userRepo.SetUserActive(ctx, tx, userID, false)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = fmt.Errorf("%w: %s", ErrUserNotFound, userID)
+			}
+			return nil, nil, err
+		}
+		users = append(users, toUserModel(user))
+	}
+
+	reassignments := []models.ReviewReassignment{}
+	for _, userID := range userIDs {
+		var handedOver []models.ReviewReassignment
+		handedOver, err = u.handover.handOverReviews(ctx, tx, userID, reviewerSourceTeams, false, fmt.Sprintf("user %s deactivated in bulk", userID))
+		if err != nil {
+			return nil, nil, err
+		}
+		reassignments = append(reassignments, handedOver...)
+	}
+
+	if req.DryRun != nil && *req.DryRun {
+		if err = tx.Rollback(); err != nil {
+			return nil, nil, err
+		}
+		return users, reassignments, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return users, reassignments, nil
+}
+
+func (u *UserServiceImpl) AddAbsence(ctx context.Context, req *api.PostUsersAddAbsenceJSONRequestBody) (*models.Absence, error) {
+	if req.UserId == "" {
+		return nil, errors.New("UserId is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return nil, ErrInvalidAbsenceWindow
+	}
+
+	tx, err := u.absenceRepo.BeginTx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,24 +289,174 @@ func (u *UserServiceImpl) SetUserActive(ctx context.Context, req *api.PostUsersS
 
 	_, err = u.userRepo.GetUserByID(ctx, tx, req.UserId)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrUserNotFound
+		}
 		return nil, err
 	}
 
-	updatedUser, err := u.userRepo.SetUserActive(ctx, tx, req.UserId, req.IsActive)
+	absence := &entity.Absence{
+		UserID:   req.UserId,
+		StartsAt: req.StartsAt.UTC(),
+		EndsAt:   req.EndsAt.UTC(),
+	}
+	if req.Reason != nil {
+		absence.Reason = *req.Reason
+	}
+
+	if err = u.absenceRepo.CreateAbsence(ctx, tx, absence); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toAbsenceModel(absence), nil
+}
+
+func (u *UserServiceImpl) GetAbsences(ctx context.Context, req *api.GetUsersGetAbsencesParams) ([]*models.Absence, error) {
+	if req.UserId == "" {
+		return nil, errors.New("UserId is required")
+	}
+
+	_, err := u.userRepo.GetUserByID(ctx, nil, req.UserId)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
 		return nil, err
 	}
 
+	absences, err := u.absenceRepo.GetAbsencesByUser(ctx, nil, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.Absence, 0, len(absences))
+	for _, absence := range absences {
+		result = append(result, toAbsenceModel(absence))
+	}
+
+	return result, nil
+}
+
+func (u *UserServiceImpl) UpdateAbsence(ctx context.Context, req *api.PostUsersUpdateAbsenceJSONRequestBody) (*models.Absence, error) {
+	tx, err := u.absenceRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	absence, err := u.absenceRepo.GetAbsenceByID(ctx, tx, req.AbsenceId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrAbsenceNotFound
+		}
+		return nil, err
+	}
+
+	if req.StartsAt != nil && !req.StartsAt.UTC().Equal(absence.StartsAt) {
+		absence.StartsAt = req.StartsAt.UTC()
+		absence.HandedOverAt = nil
+	}
+	if req.EndsAt != nil {
+		absence.EndsAt = req.EndsAt.UTC()
+	}
+	if req.Reason != nil {
+		absence.Reason = *req.Reason
+	}
+
+	if !absence.EndsAt.After(absence.StartsAt) {
+		err = ErrInvalidAbsenceWindow
+		return nil, err
+	}
+
+	if err = u.absenceRepo.UpdateAbsence(ctx, tx, absence); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toAbsenceModel(absence), nil
+}
+
+func (u *UserServiceImpl) DeleteAbsence(ctx context.Context, req *api.PostUsersDeleteAbsenceJSONRequestBody) error {
+	err := u.absenceRepo.DeleteAbsence(ctx, nil, req.AbsenceId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAbsenceNotFound
+	}
+	return err
+}
+
+func (u *UserServiceImpl) HandOverStartedAbsences(ctx context.Context) ([]models.ReviewReassignment, error) {
+	tx, err := u.absenceRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	absences, err := u.absenceRepo.GetStartedAbsences(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	reassignments := []models.ReviewReassignment{}
+	for _, absence := range absences {
+		var handedOver []models.ReviewReassignment
+		reason := fmt.Sprintf("user %s is absent", absence.UserID)
+		if absence.Reason != "" {
+			reason += ": " + absence.Reason
+		}
+
+		// The absence is temporary: without a candidate the review stays
+		// with the user rather than being dropped.
+		handedOver, err = u.handover.handOverReviews(ctx, tx, absence.UserID, reviewerSourceTeams, true, reason)
+		if err != nil {
+			return nil, err
+		}
+		reassignments = append(reassignments, handedOver...)
+
+		if err = u.absenceRepo.MarkAbsenceHandedOver(ctx, tx, absence.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
 
-	resultUser := &models.User{
-		UserId:   updatedUser.UserID,
-		Username: updatedUser.Username,
-		TeamName: updatedUser.TeamName,
-		IsActive: updatedUser.IsActive,
+	return reassignments, nil
+}
+
+func toUserModel(user *entity.User) *models.User {
+	return &models.User{
+		UserId:         user.UserID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
 	}
+}
 
-	return resultUser, nil
+func toAbsenceModel(absence *entity.Absence) *models.Absence {
+	return &models.Absence{
+		AbsenceId: absence.ID,
+		UserId:    absence.UserID,
+		StartsAt:  absence.StartsAt,
+		EndsAt:    absence.EndsAt,
+		Reason:    toOptionalString(absence.Reason),
+	}
 }
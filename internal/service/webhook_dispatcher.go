@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+type WebhookDispatcher struct {
+	logger      *slog.Logger
+	webhookRepo repository.WebhookRepository
+}
+
+func NewWebhookDispatcher(logger *slog.Logger, webhookRepo repository.WebhookRepository) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		logger:      logger,
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (d *WebhookDispatcher) Deliveries(ctx context.Context, event entity.Event) ([]EventDelivery, error) {
+	subscriptions, err := d.webhookRepo.GetSubscriptionsByEvent(ctx, nil, string(event.Type))
+	if err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryID := strconv.FormatInt(event.ID, 10)
+	deliveries := make([]EventDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		mac := hmac.New(sha256.New, []byte(subscription.Secret))
+		mac.Write(payload)
+
+		deliveries = append(deliveries, EventDelivery{
+			Target: "webhook:" + strconv.Itoa(subscription.ID),
+			URL:    subscription.URL,
+			Headers: map[string]string{
+				"X-PR-Service-Event":     string(event.Type),
+				"X-PR-Service-Delivery":  deliveryID,
+				"X-PR-Service-Signature": "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+			},
+			Payload: payload,
+			Attrs: []any{
+				slog.Int("subscription_id", subscription.ID),
+				slog.String("event", string(event.Type)),
+				slog.String("delivery_id", deliveryID),
+			},
+		})
+	}
+
+	return deliveries, nil
+}
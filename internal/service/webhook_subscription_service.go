@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/oooooorg/PR-Service/internal/entity"
+	api "github.com/oooooorg/PR-Service/internal/gen"
+	"github.com/oooooorg/PR-Service/internal/models"
+	"github.com/oooooorg/PR-Service/internal/repository"
+)
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+var ErrInvalidWebhookSubscription = errors.New("invalid webhook subscription")
+
+type WebhookSubscriptionServiceImpl struct {
+	logger      *slog.Logger
+	webhookRepo repository.WebhookRepository
+}
+
+func NewWebhookSubscriptionService(logger *slog.Logger, webhookRepo repository.WebhookRepository) WebhookSubscriptionService {
+	return &WebhookSubscriptionServiceImpl{
+		logger:      logger,
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (w *WebhookSubscriptionServiceImpl) AddSubscription(ctx context.Context, req *api.PostWebhooksAddSubscriptionJSONRequestBody) (*models.WebhookSubscription, error) {
+	target, err := url.Parse(req.Url)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidWebhookSubscription)
+	}
+	if req.Secret == "" {
+		return nil, fmt.Errorf("%w: secret is required", ErrInvalidWebhookSubscription)
+	}
+	if len(req.Events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", ErrInvalidWebhookSubscription)
+	}
+
+	events := make([]string, 0, len(req.Events))
+	for _, event := range req.Events {
+		switch entity.EventType(event) {
+		case entity.EventReviewerAssigned, entity.EventReviewerUnassigned, entity.EventPullRequestMerged,
+			entity.EventPullRequestStale, entity.EventPullRequestEscalated:
+			events = append(events, string(event))
+		default:
+			return nil, fmt.Errorf("%w: unknown event %s", ErrInvalidWebhookSubscription, event)
+		}
+	}
+
+	subscription := &entity.WebhookSubscription{
+		URL:    req.Url,
+		Secret: req.Secret,
+		Events: events,
+	}
+
+	if err := w.webhookRepo.CreateSubscription(ctx, nil, subscription); err != nil {
+		return nil, err
+	}
+
+	w.logger.Info("Webhook subscription added",
+		slog.Int("subscription_id", subscription.ID),
+		slog.String("url", subscription.URL),
+	)
+
+	return toWebhookSubscriptionModel(subscription), nil
+}
+
+func (w *WebhookSubscriptionServiceImpl) GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	subscriptions, err := w.webhookRepo.GetSubscriptions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toWebhookSubscriptionModel(subscription))
+	}
+
+	return result, nil
+}
+
+func (w *WebhookSubscriptionServiceImpl) DeleteSubscription(ctx context.Context, req *api.PostWebhooksDeleteSubscriptionJSONRequestBody) error {
+	if err := w.webhookRepo.DeleteSubscription(ctx, nil, req.SubscriptionId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrWebhookSubscriptionNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func toWebhookSubscriptionModel(subscription *entity.WebhookSubscription) *models.WebhookSubscription {
+	events := make([]models.WebhookEventType, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, models.WebhookEventType(event))
+	}
+
+	return &models.WebhookSubscription{
+		SubscriptionId: subscription.ID,
+		Url:            subscription.URL,
+		Events:         events,
+		CreatedAt:      subscription.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS assigned_reviewers_first VARCHAR(100) REFERENCES users(user_id);
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS assigned_reviewers_second VARCHAR(100) REFERENCES users(user_id);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_assigned_reviewers_first ON pull_requests(assigned_reviewers_first);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_assigned_reviewers_second ON pull_requests(assigned_reviewers_second);
+
+DO $$
+BEGIN
+    IF to_regclass('pull_request_reviewers') IS NOT NULL THEN
+        UPDATE pull_requests pr
+        SET assigned_reviewers_first = ranked.reviewer_id
+        FROM (
+            SELECT pull_request_id, reviewer_id, ROW_NUMBER() OVER (PARTITION BY pull_request_id ORDER BY position) AS rn
+            FROM pull_request_reviewers
+        ) AS ranked
+        WHERE ranked.pull_request_id = pr.pull_request_id AND ranked.rn = 1;
+
+        UPDATE pull_requests pr
+        SET assigned_reviewers_second = ranked.reviewer_id
+        FROM (
+            SELECT pull_request_id, reviewer_id, ROW_NUMBER() OVER (PARTITION BY pull_request_id ORDER BY position) AS rn
+            FROM pull_request_reviewers
+        ) AS ranked
+        WHERE ranked.pull_request_id = pr.pull_request_id AND ranked.rn = 2;
+    END IF;
+END $$;
+
+DROP INDEX IF EXISTS idx_pull_request_reviewers_pull_request_id;
+DROP INDEX IF EXISTS idx_pull_request_reviewers_reviewer_id;
+
+DROP TABLE IF EXISTS pull_request_reviewers;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS required_reviewers;
@@ -0,0 +1,31 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS required_reviewers INTEGER DEFAULT 2 NOT NULL CHECK (required_reviewers >= 1);
+
+CREATE TABLE IF NOT EXISTS pull_request_reviewers (
+    id SERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(100) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(100) NOT NULL REFERENCES users(user_id),
+    position INTEGER NOT NULL,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (pull_request_id, reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_pull_request_id ON pull_request_reviewers(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_reviewer_id ON pull_request_reviewers(reviewer_id);
+
+INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, position, assigned_at)
+SELECT pull_request_id, assigned_reviewers_first, 1, created_at
+FROM pull_requests
+WHERE assigned_reviewers_first IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, position, assigned_at)
+SELECT pull_request_id, assigned_reviewers_second, 2, created_at
+FROM pull_requests
+WHERE assigned_reviewers_second IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+DROP INDEX IF EXISTS idx_pull_requests_assigned_reviewers_first;
+DROP INDEX IF EXISTS idx_pull_requests_assigned_reviewers_second;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS assigned_reviewers_first;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS assigned_reviewers_second;
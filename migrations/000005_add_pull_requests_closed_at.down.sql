@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pull_requests_closed_at;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS closed_at;
@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_closed_at ON pull_requests(closed_at);
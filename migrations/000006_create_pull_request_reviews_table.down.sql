@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_pull_request_reviews_pull_request_id;
+DROP INDEX IF EXISTS idx_pull_request_reviews_reviewer_id;
+
+DROP TABLE IF EXISTS pull_request_reviews;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS required_approvals;
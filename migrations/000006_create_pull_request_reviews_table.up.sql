@@ -0,0 +1,14 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS required_approvals INTEGER DEFAULT 0 NOT NULL CHECK (required_approvals >= 0);
+
+CREATE TABLE IF NOT EXISTS pull_request_reviews (
+    id SERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(100) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(100) NOT NULL REFERENCES users(user_id),
+    verdict VARCHAR(50) NOT NULL CHECK (verdict IN ('APPROVE', 'REQUEST_CHANGES', 'COMMENT')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (pull_request_id, reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviews_pull_request_id ON pull_request_reviews(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviews_reviewer_id ON pull_request_reviews(reviewer_id);
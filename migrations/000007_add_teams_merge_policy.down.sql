@@ -0,0 +1,2 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS block_on_changes_requested;
+ALTER TABLE teams DROP COLUMN IF EXISTS allow_self_approval;
@@ -0,0 +1,2 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS block_on_changes_requested BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS allow_self_approval BOOLEAN DEFAULT false NOT NULL;
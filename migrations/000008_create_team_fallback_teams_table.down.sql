@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_team_fallback_teams_team_name;
+DROP TABLE IF EXISTS team_fallback_teams;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS team_fallback_teams
+(
+    id SERIAL PRIMARY KEY,
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    fallback_team_name VARCHAR(100) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    position INTEGER NOT NULL,
+    UNIQUE (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_fallback_teams_team_name ON team_fallback_teams(team_name);
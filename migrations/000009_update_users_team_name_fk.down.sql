@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_users_team_name;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE users
+    ADD CONSTRAINT users_team_name_fkey FOREIGN KEY (team_name)
+    REFERENCES teams(team_name);
+
+ALTER TABLE users ALTER COLUMN team_name SET NOT NULL;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS user_absences
+(
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT DEFAULT '' NOT NULL,
+    handed_over_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_id ON user_absences(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_absences_window ON user_absences(starts_at, ends_at);
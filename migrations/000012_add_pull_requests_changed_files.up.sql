@@ -0,0 +1 @@
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS changed_files TEXT[] DEFAULT '{}' NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_team_ownership_rules_team_name;
+DROP TABLE IF EXISTS team_ownership_rules;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS team_ownership_rules
+(
+    id SERIAL PRIMARY KEY,
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    pattern TEXT NOT NULL,
+    owners VARCHAR(100)[] NOT NULL,
+    position INTEGER NOT NULL,
+    UNIQUE (team_name, position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_ownership_rules_team_name ON team_ownership_rules(team_name);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_webhook_subscriptions_events;
+DROP TABLE IF EXISTS webhook_subscriptions;
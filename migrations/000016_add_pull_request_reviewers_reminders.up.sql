@@ -0,0 +1,2 @@
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMP;
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP;
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_pull_request_events_append_only ON pull_request_events;
+DROP FUNCTION IF EXISTS pull_request_events_append_only();
+DROP INDEX IF EXISTS idx_pull_request_events_pull_request_id;
+DROP TABLE IF EXISTS pull_request_events;
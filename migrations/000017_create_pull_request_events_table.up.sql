@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS pull_request_events
+(
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(100) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    actor_id VARCHAR(100) NOT NULL,
+    before JSONB,
+    after JSONB,
+    reason TEXT DEFAULT '' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_events_pull_request_id ON pull_request_events(pull_request_id, id);
+
+CREATE OR REPLACE FUNCTION pull_request_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'pull_request_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_pull_request_events_append_only ON pull_request_events;
+CREATE TRIGGER trg_pull_request_events_append_only
+    BEFORE UPDATE OR DELETE ON pull_request_events
+    FOR EACH ROW EXECUTE FUNCTION pull_request_events_append_only();
@@ -0,0 +1,6 @@
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS delivered_targets TEXT[] DEFAULT '{}' NOT NULL;
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL;
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at, id) WHERE delivered_at IS NULL AND failed_at IS NULL;
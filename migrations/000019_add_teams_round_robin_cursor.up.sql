@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS round_robin_cursor VARCHAR(100);